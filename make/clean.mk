@@ -36,7 +36,7 @@ clean-nstemplatetiers: clean-warning
 ## Delete all cluster-wide configuration resources like PriorityClass, MutatingWebhookConfiguration, and ClusterRoleBinding for e2e SA
 clean-cluster-wide-config: clean-warning
 	$(Q)-oc get ClusterRoleBinding -o name | grep e2e-service-account | xargs oc delete
-	$(Q)-oc delete ClusterRoleBinding e2e-test-cluster-admin
+	$(Q)-oc get ClusterRoleBinding -o name | grep e2e-test-cluster-admin | xargs oc delete
 	$(Q)-oc get ClusterRole -o jsonpath="{range .items[*]}{.metadata.name} {.metadata.labels.olm\.owner}{'\n'}{end}" | grep "toolchain-" | awk '{print $$1}' | xargs oc delete ClusterRole
 	$(Q)-oc get ClusterRoleBinding -o jsonpath="{range .items[*]}{.metadata.name} {.metadata.labels.olm\.owner}{'\n'}{end}" | grep "toolchain-" | awk '{print $$1}' | xargs oc delete ClusterRoleBinding
 	$(Q)-oc delete PriorityClass -l='toolchain.dev.openshift.com/provider=codeready-toolchain'
@@ -61,6 +61,12 @@ clean-toolchain-dev-sso-resources:
 ##    * cluster-wide config
 clean-e2e-resources: clean-users clean-nstemplatetiers clean-toolchain-namespaces-in-e2e clean-cluster-wide-config
 
+.PHONY: clean-e2e-stale-runs
+## Delete the objects created by the e2e tests of the previous runs (eg, after an aborted job), in dependency order.
+## Only the objects created more than 2h ago are deleted, so that the runs in progress are not affected: set SWEEP_OLDER_THAN (eg, 30m, or 0 for all the objects) to change it.
+clean-e2e-stale-runs:
+	$(MAKE) execute-tests MEMBER_NS=${MEMBER_NS} MEMBER_NS_2=${MEMBER_NS_2} HOST_NS=${HOST_NS} REGISTRATION_SERVICE_NS=${REGISTRATION_SERVICE_NS} TESTS_TO_EXECUTE="./test/sweep"
+
 .PHONY: clean-toolchain-namespaces-in-dev
 ## Delete dev namespaces
 clean-toolchain-namespaces-in-dev: clean-toolchain-dev-sso-resources
@@ -17,12 +17,17 @@ build:
 
 .PHONY: verify-dependencies
 ## Runs commands to verify after the updated dependecies of toolchain-common/API(go mod replace), if the repo needs any changes to be made
-verify-dependencies: tidy vet go-test-skip-all test lint-go-code
+verify-dependencies: tidy generate vet go-test-skip-all test lint-go-code
 
 .PHONY: tidy
 tidy: 
 	go mod tidy
 
+.PHONY: generate
+## Generate the typed waiters of the toolchain kinds, eg after the API dependency was updated with new kinds
+generate:
+	go generate ./testsupport/wait/...
+
 .PHONY: vet
 vet:
 	go vet ./...
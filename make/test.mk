@@ -33,11 +33,21 @@ endif
 
 E2E_TEST_EXECUTION ?= true
 
+# the ID of the run, set as a label on the objects created by the tests so that they can be swept after an aborted run.
+# It's computed once (and passed to the sub-makes via the environment) so that all the test packages share the same ID
+ifndef E2E_RUN_ID
+E2E_RUN_ID := $(shell date -u +'%Y%m%d-%H%M%S')
+endif
+export E2E_RUN_ID
+
 ifeq ($(DISABLE_KUBE_CLIENT_TLS_VERIFY),true)
 KSCTL_TLS_VERIFY_PARAM := --insecure-skip-tls-verify=true
 endif
 
 TESTS_RUN_FILTER_REGEXP ?= ""
+# comma-separated tags of the tests to run or to skip, eg: TESTS_EXCLUDE_TAGS=second-member,phone-verification (see testsupport.Requires)
+TESTS_INCLUDE_TAGS ?= ""
+TESTS_EXCLUDE_TAGS ?= ""
 
 .PHONY: test-e2e
 ## Run the e2e tests
@@ -174,7 +184,7 @@ execute-tests:
 	# One might wonder whether the word "idiomatic" shouldn't have been spelled with 2 letters less there.
 	# We need to turn off the cache because the e2e tests depend on running the migration setup. If the results of the migration tests were
 	# cached, it might happen that the cluster is in an unprepared state when the e2e tests start running.
-	MEMBER_NS=${MEMBER_NS} MEMBER_NS_2=${MEMBER_NS_2} HOST_NS=${HOST_NS} REGISTRATION_SERVICE_NS=${REGISTRATION_SERVICE_NS} SECOND_MEMBER_MODE=${SECOND_MEMBER_MODE} go test ${TESTS_TO_EXECUTE} -run ${TESTS_RUN_FILTER_REGEXP} -p 1 -v -timeout=90m -failfast -count=1 || \
+	MEMBER_NS=${MEMBER_NS} MEMBER_NS_2=${MEMBER_NS_2} HOST_NS=${HOST_NS} REGISTRATION_SERVICE_NS=${REGISTRATION_SERVICE_NS} SECOND_MEMBER_MODE=${SECOND_MEMBER_MODE} E2E_INCLUDE_TAGS=${TESTS_INCLUDE_TAGS} E2E_EXCLUDE_TAGS=${TESTS_EXCLUDE_TAGS} go test ${TESTS_TO_EXECUTE} -run ${TESTS_RUN_FILTER_REGEXP} -p 1 -v -timeout=90m -failfast -count=1 || \
 	($(MAKE) print-logs HOST_NS=${HOST_NS} MEMBER_NS=${MEMBER_NS} MEMBER_NS_2=${MEMBER_NS_2} REGISTRATION_SERVICE_NS=${REGISTRATION_SERVICE_NS} && exit 1)
 
 .PHONY: print-logs
@@ -0,0 +1,272 @@
+package activity
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/setup/terminal"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ActivityLabelKey is set on all the objects created by the activity generator
+	ActivityLabelKey = "toolchain.dev.openshift.com/setup-activity"
+
+	ScaleDeployment = "scale-deployment"
+	ConfigMap       = "configmap"
+	Secret          = "secret"
+	Job             = "job"
+	RestartPod      = "restart-pod"
+
+	jobImage = "registry.access.redhat.com/ubi9/ubi-minimal"
+)
+
+// Operations are all the operations that can be performed on behalf of the users
+var Operations = []string{ScaleDeployment, ConfigMap, Secret, Job, RestartPod}
+
+type operation func(ctx context.Context, cl client.Client, namespace string) error
+
+var operations = map[string]operation{
+	ScaleDeployment: scaleDeployment,
+	ConfigMap:       toggleConfigMap,
+	Secret:          toggleSecret,
+	Job:             runJob,
+	RestartPod:      restartPod,
+}
+
+// Generator periodically performs operations in the namespaces of the provisioned users, so that the load on the
+// operators reflects active users and not just dormant ones
+type Generator struct {
+	k8sClient  client.Client
+	term       terminal.Terminal
+	namespaces []string
+	ops        []string
+	// rate is the number of operations per second per user
+	rate        float64
+	concurrency int
+
+	mu      sync.Mutex
+	results map[string]opResult
+}
+
+type opResult struct {
+	count   int
+	errors  int
+	skipped int
+}
+
+// New returns a new activity generator for the given users, performing the given operations at the given rate (operations per second per user)
+func New(t terminal.Terminal, cl client.Client, usernames []string, ops []string, rate float64) (*Generator, error) {
+	if rate <= 0 {
+		return nil, fmt.Errorf("the activity rate must be more than 0")
+	}
+	if len(usernames) == 0 {
+		return nil, fmt.Errorf("at least one user is required to generate activity")
+	}
+	for _, op := range ops {
+		if _, ok := operations[op]; !ok {
+			return nil, fmt.Errorf("unknown activity operation '%s', supported operations are %v", op, Operations)
+		}
+	}
+	namespaces := make([]string, 0, len(usernames))
+	for _, u := range usernames {
+		namespaces = append(namespaces, fmt.Sprintf("%s-dev", u))
+	}
+	return &Generator{
+		k8sClient:   cl,
+		term:        t,
+		namespaces:  namespaces,
+		ops:         ops,
+		rate:        rate,
+		concurrency: 10,
+		results:     make(map[string]opResult, len(ops)),
+	}, nil
+}
+
+// Start performs operations at the configured rate until the returned channel is closed
+func (g *Generator) Start() chan struct{} {
+	stop := make(chan struct{})
+	interval := time.Duration(float64(time.Second) / (g.rate * float64(len(g.namespaces))))
+	if interval <= 0 {
+		interval = time.Nanosecond
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	work := make(chan func(), g.concurrency)
+	for i := 0; i < g.concurrency; i++ {
+		go func() {
+			for w := range work {
+				w()
+			}
+		}()
+	}
+
+	go func() {
+		defer close(work)
+		defer cancel()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				op := g.ops[rand.Intn(len(g.ops))]               // nolint:gosec
+				ns := g.namespaces[rand.Intn(len(g.namespaces))] // nolint:gosec
+				select {
+				case work <- func() { g.perform(ctx, op, ns) }:
+				default:
+					// all the workers are busy, the cluster can't keep up with the configured rate
+					g.record(op, nil, true)
+				}
+			}
+		}
+	}()
+	return stop
+}
+
+func (g *Generator) perform(ctx context.Context, op, namespace string) {
+	err := operations[op](ctx, g.k8sClient, namespace)
+	if err != nil {
+		g.term.Debugf("activity operation '%s' failed in namespace '%s': %s", op, namespace, err)
+	}
+	g.record(op, err, false)
+}
+
+func (g *Generator) record(op string, err error, skipped bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	r := g.results[op]
+	switch {
+	case skipped:
+		r.skipped++
+	case err != nil:
+		r.errors++
+	default:
+		r.count++
+	}
+	g.results[op] = r
+}
+
+// ComputeResults returns the number of operations that were performed, failed or skipped, per operation
+func (g *Generator) ComputeResults() [][]string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	ops := make([]string, 0, len(g.results))
+	for op := range g.results {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+	tuples := [][]string{
+		{"Activity Rate (ops/s per user)", fmt.Sprintf("%.4f", g.rate)},
+	}
+	for _, op := range ops {
+		r := g.results[op]
+		tuples = append(tuples,
+			[]string{fmt.Sprintf("Activity %s Operations", op), fmt.Sprintf("%d", r.count)},
+			[]string{fmt.Sprintf("Activity %s Errors", op), fmt.Sprintf("%d", r.errors)},
+			[]string{fmt.Sprintf("Activity %s Skipped", op), fmt.Sprintf("%d", r.skipped)},
+		)
+	}
+	return tuples
+}
+
+// scaleDeployment scales a deployment of the namespace up if it has no replicas, down otherwise
+func scaleDeployment(ctx context.Context, cl client.Client, namespace string) error {
+	deployments := &appsv1.DeploymentList{}
+	if err := cl.List(ctx, deployments, client.InNamespace(namespace)); err != nil {
+		return err
+	}
+	if len(deployments.Items) == 0 {
+		return fmt.Errorf("no deployment found")
+	}
+	d := deployments.Items[rand.Intn(len(deployments.Items))] // nolint:gosec
+	replicas := int32(1)
+	if d.Spec.Replicas != nil && *d.Spec.Replicas > 0 {
+		replicas = 0
+	}
+	d.Spec.Replicas = &replicas
+	return cl.Update(ctx, &d)
+}
+
+// toggleConfigMap deletes the configmap created by a previous operation, or creates it if it doesn't exist
+func toggleConfigMap(ctx context.Context, cl client.Client, namespace string) error {
+	return toggle(ctx, cl, &corev1.ConfigMap{
+		ObjectMeta: activityObjectMeta(namespace, "activity-configmap"),
+		Data:       map[string]string{"updated": time.Now().String()},
+	})
+}
+
+// toggleSecret deletes the secret created by a previous operation, or creates it if it doesn't exist
+func toggleSecret(ctx context.Context, cl client.Client, namespace string) error {
+	return toggle(ctx, cl, &corev1.Secret{
+		ObjectMeta: activityObjectMeta(namespace, "activity-secret"),
+		StringData: map[string]string{"updated": time.Now().String()},
+	})
+}
+
+func toggle(ctx context.Context, cl client.Client, obj client.Object) error {
+	err := cl.Delete(ctx, obj)
+	if client.IgnoreNotFound(err) != nil {
+		return err
+	}
+	if err == nil {
+		return nil
+	}
+	return cl.Create(ctx, obj)
+}
+
+// runJob creates a short Job which is deleted automatically once it has finished
+func runJob(ctx context.Context, cl client.Client, namespace string) error {
+	meta := activityObjectMeta(namespace, "")
+	meta.GenerateName = "activity-job-"
+	ttl := int32(30)
+	backoffLimit := int32(0)
+	return cl.Create(ctx, &batchv1.Job{
+		ObjectMeta: meta,
+		Spec: batchv1.JobSpec{
+			TTLSecondsAfterFinished: &ttl,
+			BackoffLimit:            &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{{
+						Name:    "activity",
+						Image:   jobImage,
+						Command: []string{"sleep", "1"},
+					}},
+				},
+			},
+		},
+	})
+}
+
+// restartPod deletes a pod of the namespace, pods of deployments and replicasets are then recreated by their controllers
+func restartPod(ctx context.Context, cl client.Client, namespace string) error {
+	pods := &corev1.PodList{}
+	if err := cl.List(ctx, pods, client.InNamespace(namespace)); err != nil {
+		return err
+	}
+	if len(pods.Items) == 0 {
+		// the pods may have been idled, nothing to restart
+		return nil
+	}
+	p := pods.Items[rand.Intn(len(pods.Items))] // nolint:gosec
+	return client.IgnoreNotFound(cl.Delete(ctx, &p))
+}
+
+func activityObjectMeta(namespace, name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Namespace: namespace,
+		Name:      name,
+		Labels:    map[string]string{ActivityLabelKey: "true"},
+	}
+}
@@ -0,0 +1,178 @@
+package activity
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/setup/terminal"
+	"github.com/codeready-toolchain/toolchain-e2e/setup/test"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestNew(t *testing.T) {
+	term := newTerminal()
+
+	t.Run("success", func(t *testing.T) {
+		// when
+		g, err := New(term, test.NewFakeClient(t), []string{"zippy-0001", "zippy-0002"}, Operations, 0.5)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"zippy-0001-dev", "zippy-0002-dev"}, g.namespaces)
+	})
+
+	t.Run("invalid rate", func(t *testing.T) {
+		// when
+		_, err := New(term, test.NewFakeClient(t), []string{"zippy-0001"}, Operations, 0)
+
+		// then
+		require.EqualError(t, err, "the activity rate must be more than 0")
+	})
+
+	t.Run("unknown operation", func(t *testing.T) {
+		// when
+		_, err := New(term, test.NewFakeClient(t), []string{"zippy-0001"}, []string{"unknown"}, 1)
+
+		// then
+		require.ErrorContains(t, err, "unknown activity operation 'unknown'")
+	})
+}
+
+func TestOperations(t *testing.T) {
+	ns := "zippy-0001-dev"
+
+	t.Run("scale deployment", func(t *testing.T) {
+		// given
+		replicas := int32(0)
+		cl := test.NewFakeClient(t, &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "nginx-deployment", Namespace: ns},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		})
+		d := &appsv1.Deployment{}
+
+		// when
+		err := scaleDeployment(context.TODO(), cl, ns)
+
+		// then
+		require.NoError(t, err)
+		require.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: ns, Name: "nginx-deployment"}, d))
+		assert.Equal(t, int32(1), *d.Spec.Replicas)
+
+		// and when scaling again
+		err = scaleDeployment(context.TODO(), cl, ns)
+
+		// then
+		require.NoError(t, err)
+		require.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: ns, Name: "nginx-deployment"}, d))
+		assert.Equal(t, int32(0), *d.Spec.Replicas)
+	})
+
+	t.Run("toggle configmap", func(t *testing.T) {
+		// given
+		cl := test.NewFakeClient(t)
+		key := types.NamespacedName{Namespace: ns, Name: "activity-configmap"}
+
+		// when
+		err := toggleConfigMap(context.TODO(), cl, ns)
+
+		// then
+		require.NoError(t, err)
+		cm := &corev1.ConfigMap{}
+		require.NoError(t, cl.Get(context.TODO(), key, cm))
+		assert.Equal(t, "true", cm.Labels[ActivityLabelKey])
+
+		// and when toggling again
+		err = toggleConfigMap(context.TODO(), cl, ns)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, errors.IsNotFound(cl.Get(context.TODO(), key, cm)))
+	})
+
+	t.Run("toggle secret", func(t *testing.T) {
+		// given
+		cl := test.NewFakeClient(t)
+
+		// when
+		err := toggleSecret(context.TODO(), cl, ns)
+
+		// then
+		require.NoError(t, err)
+		require.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: ns, Name: "activity-secret"}, &corev1.Secret{}))
+	})
+
+	t.Run("run job", func(t *testing.T) {
+		// given
+		cl := test.NewFakeClient(t)
+
+		// when
+		err := runJob(context.TODO(), cl, ns)
+
+		// then
+		require.NoError(t, err)
+		jobs := &batchv1.JobList{}
+		require.NoError(t, cl.List(context.TODO(), jobs, client.InNamespace(ns)))
+		require.Len(t, jobs.Items, 1)
+		assert.Equal(t, int32(30), *jobs.Items[0].Spec.TTLSecondsAfterFinished)
+	})
+
+	t.Run("restart pod", func(t *testing.T) {
+		// given
+		cl := test.NewFakeClient(t, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "nginx", Namespace: ns}})
+
+		// when
+		err := restartPod(context.TODO(), cl, ns)
+
+		// then
+		require.NoError(t, err)
+		pods := &corev1.PodList{}
+		require.NoError(t, cl.List(context.TODO(), pods, client.InNamespace(ns)))
+		assert.Empty(t, pods.Items)
+	})
+
+	t.Run("restart pod without any pod", func(t *testing.T) {
+		// when
+		err := restartPod(context.TODO(), test.NewFakeClient(t), ns)
+
+		// then
+		require.NoError(t, err)
+	})
+}
+
+func TestGenerator(t *testing.T) {
+	// given
+	cl := test.NewFakeClient(t)
+	g, err := New(newTerminal(), cl, []string{"zippy-0001"}, []string{ConfigMap, ScaleDeployment}, 100)
+	require.NoError(t, err)
+
+	// when
+	stop := g.Start()
+	time.Sleep(200 * time.Millisecond)
+	close(stop)
+
+	// then
+	results := g.ComputeResults()
+	assert.Equal(t, []string{"Activity Rate (ops/s per user)", "100.0000"}, results[0])
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	assert.Positive(t, g.results[ConfigMap].count)
+	assert.Positive(t, g.results[ScaleDeployment].errors) // there's no deployment to scale
+}
+
+func newTerminal() terminal.Terminal {
+	out := &bytes.Buffer{}
+	return terminal.New(func() io.Reader { return strings.NewReader("") }, func() io.Writer { return out }, false)
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"os"
+
+	cfg "github.com/codeready-toolchain/toolchain-e2e/setup/configuration"
+	"github.com/codeready-toolchain/toolchain-e2e/setup/history"
+	"github.com/codeready-toolchain/toolchain-e2e/setup/metadata"
+	"github.com/codeready-toolchain/toolchain-e2e/setup/terminal"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	historyTestname string
+	historyMetric   string
+	historyHTML     string
+)
+
+// the flags which shape the workload of a run. The runs are only compared with the runs which have the same workload shape,
+// but not necessarily the same number of users or the same templates.
+var historyWorkloadFlags = []string{
+	"operators-limit",
+	"skip-install-operators",
+	"skip-idler",
+	"idler-timeout",
+	"activity-rate",
+	"activity-ops",
+}
+
+func newHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "history",
+		Short:         "render the trend of the results across the previous runs",
+		SilenceErrors: true,
+		SilenceUsage:  false,
+		Args:          cobra.NoArgs,
+		Run:           showHistory,
+	}
+	cmd.Flags().StringVar(&historyTestname, "testname", "", "only include the runs with the given testname")
+	cmd.Flags().StringVar(&historyMetric, "metric", "", "only include the metrics containing the given text (case-insensitive)")
+	cmd.Flags().StringVar(&historyHTML, "html", "", "path of an HTML file to write with a chart per metric")
+	return cmd
+}
+
+func showHistory(cmd *cobra.Command, _ []string) {
+	cmd.SilenceUsage = true
+	term := terminal.New(cmd.InOrStdin, cmd.OutOrStdout, verbose)
+	cfg.Init(term)
+
+	runs, err := history.NewStore(cfg.HistoryDir()).Load()
+	if err != nil {
+		term.Fatalf(err, "unable to load the run history from %s", cfg.HistoryDir())
+	}
+	groups := history.NewGroups(runs, historyTestname, historyMetric)
+	if len(groups) == 0 {
+		term.Infof("no runs found in %s", cfg.HistoryDir())
+		return
+	}
+	if err := history.WriteTables(cmd.OutOrStdout(), groups); err != nil {
+		term.Fatalf(err, "unable to write the trend tables")
+	}
+
+	if historyHTML != "" {
+		f, err := os.Create(historyHTML)
+		if err != nil {
+			term.Fatalf(err, "unable to create the HTML file %s", historyHTML)
+		}
+		defer f.Close()
+		if err := history.WriteHTML(f, groups); err != nil {
+			term.Fatalf(err, "unable to write the HTML charts")
+		}
+		term.Infof("\nHTML charts: %s", historyHTML)
+	}
+}
+
+// addToHistory appends the results of the current run to the run history
+func addToHistory(term terminal.Terminal, cmd *cobra.Command, runMetadata metadata.Metadata, rows [][]string) {
+	run := history.Run{
+		Testname:         cfg.Testname,
+		StartedAt:        cfg.StartedTimestamp(),
+		ClusterVersion:   runMetadata.OpenShiftVersion,
+		ClusterNodes:     runMetadata.ClusterNodes(),
+		OperatorVersions: runMetadata.OperatorVersions(),
+		Workload:         map[string]string{},
+		Results:          history.NewResults(rows),
+	}
+	for _, name := range historyWorkloadFlags {
+		if f := cmd.Flags().Lookup(name); f != nil {
+			run.Workload[name] = f.Value.String()
+		}
+	}
+
+	path, err := history.NewStore(cfg.HistoryDir()).Append(run)
+	if err != nil {
+		term.Errorf(err, "unable to add the run to the history")
+		return
+	}
+	term.Infof("Run history file: %s", path)
+}
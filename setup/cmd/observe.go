@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	cfg "github.com/codeready-toolchain/toolchain-e2e/setup/configuration"
+	"github.com/codeready-toolchain/toolchain-e2e/setup/metadata"
+	"github.com/codeready-toolchain/toolchain-e2e/setup/metrics"
+	"github.com/codeready-toolchain/toolchain-e2e/setup/results"
+	"github.com/codeready-toolchain/toolchain-e2e/setup/terminal"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	observeDuration time.Duration
+	observeInterval time.Duration
+)
+
+func newObserveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "observe",
+		Short:         "gather metrics of an already populated cluster without provisioning any user",
+		SilenceErrors: true,
+		SilenceUsage:  false,
+		Args:          cobra.NoArgs,
+		Run:           observe,
+	}
+
+	cmd.Flags().DurationVar(&observeDuration, "duration", time.Hour, "how long the metrics should be gathered for, eg. 8h")
+	cmd.Flags().DurationVar(&observeInterval, "interval", 5*time.Minute, "the interval between two samples of the metrics")
+	cmd.Flags().StringVar(&kubeconfig, "kubeconfig", "", "absolute path to the kubeconfig file")
+	cmd.Flags().StringVar(&cfg.HostOperatorNamespace, "host-ns", cfg.DefaultHostNS, "the namespace of Host operator")
+	cmd.Flags().StringVar(&cfg.MemberOperatorNamespace, "member-ns", cfg.DefaultMemberNS, "the namespace of the Member operator")
+	cmd.Flags().StringVar(&cfg.Testname, "testname", "", "a name that is added as a suffix to the result file names")
+	cmd.Flags().StringVarP(&token, "token", "t", "", "Openshift API token")
+	cmd.Flags().StringSliceVar(&workloads, "workloads", []string{}, "workload namespace:name pairs that should have metrics collected. all values are comma-separated eg. \"--workloads service-binding-operator:service-binding-operator,rhoas-operator:rhoas-operator\"")
+	return cmd
+}
+
+// observe gathers the metrics of the cluster for the given duration. It only reads from the cluster: no user is created,
+// the ToolchainConfig is not changed and no operator is installed.
+func observe(cmd *cobra.Command, _ []string) {
+	cmd.SilenceUsage = true
+	term := terminal.New(cmd.InOrStdin, cmd.OutOrStdout, verbose)
+
+	// call cfg.Init() to initialize variables that are dependent on any flags eg. testname
+	cfg.Init(term)
+
+	if observeDuration <= 0 {
+		term.Fatalf(fmt.Errorf("value must be more than 0"), "invalid duration value '%s'", observeDuration)
+	}
+	if observeInterval <= 0 {
+		term.Fatalf(fmt.Errorf("value must be more than 0"), "invalid interval value '%s'", observeInterval)
+	}
+	validateWorkloads(term)
+
+	term.Infof("🕖 initializing...\n")
+	cl, config, scheme, err := cfg.NewClient(term, kubeconfig)
+	if err != nil {
+		term.Fatalf(err, "cannot create client")
+	}
+	ensureToken(term, cl)
+
+	metricsInstance := metrics.New(term, cl, token, observeInterval)
+	prometheusClient := metrics.GetPrometheusClient(term, cl, token)
+	addWorkloadQueries(term, cl, metricsInstance, prometheusClient)
+
+	runMetadata := metadata.Collect(term, cl, config, metadataNamespaces(term, scheme))
+	generalResultsInfo := append([][]string{
+		{"Observation Duration", observeDuration.String()},
+	}, runMetadata.Rows()...)
+
+	resultsWriter := results.New(term)
+	outputResults := func() {
+		addAndOutputResults(term, resultsWriter, func() [][]string { return generalResultsInfo }, metricsInstance.ComputeResults)
+	}
+	// ensure metrics are dumped even if there's a fatal error
+	term.AddPreFatalExitHook(outputResults)
+
+	// stop early (and still output the results) if the command is interrupted
+	ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	startTime := time.Now()
+	term.Infof("🔭 gathering metrics for %s...", observeDuration)
+	stopMetrics := metricsInstance.StartGathering()
+	select {
+	case <-time.After(observeDuration):
+	case <-ctx.Done():
+		term.Infof("interrupted, stopping the observation")
+	}
+	if stopMetrics != nil {
+		close(stopMetrics)
+	}
+
+	if elapsed := time.Since(startTime); elapsed < observeDuration {
+		generalResultsInfo[0] = []string{"Observation Duration", elapsed.Round(time.Second).String()}
+	}
+	outputResults()
+	addToHistory(term, cmd, runMetadata, resultsWriter.Rows())
+	term.Infof("👋 have fun!")
+}
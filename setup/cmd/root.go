@@ -11,9 +11,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/codeready-toolchain/toolchain-e2e/setup/activity"
 	"github.com/codeready-toolchain/toolchain-e2e/setup/auth"
 	cfg "github.com/codeready-toolchain/toolchain-e2e/setup/configuration"
 	"github.com/codeready-toolchain/toolchain-e2e/setup/idlers"
+	"github.com/codeready-toolchain/toolchain-e2e/setup/metadata"
 	"github.com/codeready-toolchain/toolchain-e2e/setup/metrics"
 	"github.com/codeready-toolchain/toolchain-e2e/setup/metrics/queries"
 	"github.com/codeready-toolchain/toolchain-e2e/setup/operators"
@@ -25,10 +27,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/gosuri/uiprogress"
 	"github.com/gosuri/uitable/util/strutil"
+	prometheus "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/spf13/cobra"
 )
 
@@ -48,6 +52,8 @@ var (
 	idlerTimeout         string
 	token                string
 	workloads            []string
+	activityRate         float64
+	activityOps          []string
 )
 
 var (
@@ -70,7 +76,6 @@ func Execute() {
 
 	cmd.Flags().StringVar(&usernamePrefix, "username", usernamePrefix, "the prefix used for usersignup names")
 	cmd.Flags().StringVar(&kubeconfig, "kubeconfig", "", "absolute path to the kubeconfig file")
-	cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "if 'debug' traces should be displayed in the console")
 	cmd.Flags().IntVarP(&numberOfUsers, "users", "u", 2000, "the number of user accounts to provision")
 	cmd.Flags().StringVar(&cfg.HostOperatorNamespace, "host-ns", cfg.DefaultHostNS, "the namespace of Host operator")
 	cmd.Flags().StringVar(&cfg.MemberOperatorNamespace, "member-ns", cfg.DefaultMemberNS, "the namespace of the Member operator")
@@ -87,6 +92,11 @@ func Execute() {
 	cmd.Flags().StringVarP(&token, "token", "t", "", "Openshift API token")
 	cmd.Flags().StringSliceVar(&workloads, "workloads", []string{}, "workload namespace:name pairs that should have metrics collected during the setup. all values are comma-separated eg. \"--workloads service-binding-operator:service-binding-operator,rhoas-operator:rhoas-operator\"")
 
+	cmd.Flags().Float64Var(&activityRate, "activity-rate", 0, "the number of operations per second per user performed on behalf of the users while gathering metrics after provisioning (disabled by default, can't be used with --skip-wait)")
+	cmd.Flags().StringSliceVar(&activityOps, "activity-ops", activity.Operations, fmt.Sprintf("the operations performed on behalf of the users when the activity is enabled, all values are comma-separated. supported operations are %v", activity.Operations))
+	cmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "if 'debug' traces should be displayed in the console")
+	cmd.AddCommand(newHistoryCmd(), newObserveCmd())
+
 	if err := cmd.Execute(); err != nil {
 		fmt.Println(err)
 		os.Exit(1)
@@ -133,11 +143,13 @@ func setup(cmd *cobra.Command, _ []string) { // nolint:gocyclo
 		term.Fatalf(errors.New(""), "'%d' users are set to have custom templates applied but no custom templates were provided", customTemplateUsers)
 	}
 
-	for _, w := range workloads {
-		pair := strings.Split(w, ":")
-		if len(pair)%2 == 1 {
-			term.Fatalf(err, "invalid workloads values provided '%v' - values must be namespace:name pairs", workloads)
-		}
+	validateWorkloads(term)
+
+	if activityRate < 0 {
+		term.Fatalf(fmt.Errorf("value must be 0 or more"), "invalid activity rate value '%f'", activityRate)
+	}
+	if activityRate > 0 && skipAdditionalWait {
+		term.Fatalf(errors.New("the activity is generated during the additional wait"), "the '--activity-rate' and '--skip-wait' flags can't be used together")
 	}
 
 	// add the default user-workloads.yaml file automatically
@@ -149,17 +161,7 @@ func setup(cmd *cobra.Command, _ []string) { // nolint:gocyclo
 		term.Fatalf(err, "cannot create client")
 	}
 
-	if len(token) == 0 {
-		token, err = auth.GetTokenFromOC()
-		if err != nil {
-			tokenRequestURI, err := auth.GetTokenRequestURI(cl)
-			errMsg := "a token is required to capture metrics, use oc login with token to log into the cluster. eg. `oc login --token=<token> --server=<server>`"
-			if err != nil {
-				term.Fatalf(err, errMsg)
-			}
-			term.Fatalf(fmt.Errorf("a token can be requested from %s", tokenRequestURI), errMsg)
-		}
-	}
+	ensureToken(term, cl)
 
 	var templateListStr string
 	templateListStr += "\n - (default) " + defaultTemplatePath
@@ -208,11 +210,7 @@ func setup(cmd *cobra.Command, _ []string) { // nolint:gocyclo
 	if !skipInstallOperators {
 		term.Infof("⏳ installing operators...")
 		// install operators for member clusters
-		templatePaths := []string{}
-		for i := 0; i < operatorsLimit; i++ {
-			templatePaths = append(templatePaths, "setup/operators/installtemplates/"+operators.Templates[i])
-		}
-		if err := operators.EnsureOperatorsInstalled(cmd.Context(), cl, scheme, templatePaths); err != nil {
+		if err := operators.EnsureOperatorsInstalled(cmd.Context(), cl, scheme, installTemplatePaths(operatorsLimit)); err != nil {
 			term.Fatalf(err, "failed to ensure all operators are installed")
 		}
 	}
@@ -224,21 +222,30 @@ func setup(cmd *cobra.Command, _ []string) { // nolint:gocyclo
 	metricsInstance := metrics.New(term, cl, token, 5*time.Minute)
 
 	prometheusClient := metrics.GetPrometheusClient(term, cl, token)
+
+	// collect the run context so that the results can be compared with other runs
+	term.Infof("🔎 collecting cluster and operator metadata...")
+	runMetadata := metadata.Collect(term, cl, config, metadataNamespaces(term, scheme))
+	generalResultsInfo = append(generalResultsInfo, runMetadata.Rows()...)
 	// add queries for each custom workload
-	for _, w := range workloads {
-		pair := strings.Split(w, ":")
-		if len(pair) != 2 {
-			term.Fatalf(err, "invalid workloads values provided '%v' - values must be namespace:name pairs", workloads)
+	addWorkloadQueries(term, cl, metricsInstance, prometheusClient)
+
+	var activityGenerator *activity.Generator
+	if activityRate > 0 {
+		usernames := make([]string, 0, numberOfUsers)
+		for i := 1; i <= numberOfUsers; i++ {
+			usernames = append(usernames, fmt.Sprintf("%s-%04d", usernamePrefix, i))
 		}
-		if err := cl.Get(context.TODO(), types.NamespacedName{Namespace: pair[0], Name: pair[1]}, &appsv1.Deployment{}); err != nil {
-			term.Fatalf(err, "invalid workload provided '%s'", w)
+		if activityGenerator, err = activity.New(term, cl, usernames, activityOps, activityRate); err != nil {
+			term.Fatalf(err, "invalid activity configuration")
 		}
-		metricsInstance.AddQueries(
-			queries.QueryWorkloadCPUUsage(prometheusClient, pair[0], pair[1]),
-			queries.QueryWorkloadMemoryUsage(prometheusClient, pair[0], pair[1]),
-		)
 	}
 
+	// capture the state of the control plane before provisioning to report on the impact of the sandbox
+	controlPlaneImpact := metrics.NewControlPlaneImpact(term, cl, prometheusClient)
+	term.Infof("📸 capturing control plane state before provisioning...")
+	controlPlaneImpact.Capture(metrics.BeforeProvisioning)
+
 	// redirect stdout and stderr to files due to issue with progress bars and client go logging for messages like
 	// I0619 11:12:22.620509   89316 request.go:601] Waited for 1.100053529s due to client-side throttling, not priority and fairness, request: POST:https://api.rajiv.devcluster.openshift.com:6443/apis/rbac.authorization.k8s.io/v1/namespaces/waffle4-0001-dev/rolebindings
 	tempStdout := os.Stdout
@@ -265,8 +272,12 @@ func setup(cmd *cobra.Command, _ []string) { // nolint:gocyclo
 	// gather and write results
 	resultsWriter := results.New(term)
 
+	resultFuncs := []func() [][]string{func() [][]string { return generalResultsInfo }, metricsInstance.ComputeResults, controlPlaneImpact.ComputeResults}
+	if activityGenerator != nil {
+		resultFuncs = append(resultFuncs, activityGenerator.ComputeResults)
+	}
 	outputResults := func() {
-		addAndOutputResults(term, resultsWriter, func() [][]string { return generalResultsInfo }, metricsInstance.ComputeResults)
+		addAndOutputResults(term, resultsWriter, resultFuncs...)
 	}
 	// ensure metrics are dumped even if there's a fatal error
 	term.AddPreFatalExitHook(outputResults)
@@ -347,10 +358,22 @@ func setup(cmd *cobra.Command, _ []string) { // nolint:gocyclo
 	// continue gathering metrics for some time after creating all users and resources since memory usage was observed to continue changing
 	if !skipAdditionalWait {
 		additionalMetricsDuration := 15 * time.Minute
+		// perform operations on behalf of the users so that the operators are measured with active users
+		var stopActivity chan struct{}
+		if activityGenerator != nil {
+			term.Infof("🏃 generating activity for %d users at %.4f operations per second per user...", numberOfUsers, activityRate)
+			stopActivity = activityGenerator.Start()
+		}
 		term.Infof("Continuing to gather metrics for %s...", additionalMetricsDuration)
 		time.Sleep(additionalMetricsDuration)
+		if stopActivity != nil {
+			close(stopActivity)
+		}
 	}
 
+	term.Infof("📸 capturing control plane state after provisioning...")
+	controlPlaneImpact.Capture(metrics.AfterProvisioning)
+
 	// =====================
 	// end of setup
 	// =====================
@@ -374,9 +397,73 @@ func setup(cmd *cobra.Command, _ []string) { // nolint:gocyclo
 	)
 
 	outputResults()
+	addToHistory(term, cmd, runMetadata, resultsWriter.Rows())
 	term.Infof("👋 have fun!")
 }
 
+// ensureToken retrieves the token from `oc` if it was not provided, since a token is required to capture metrics
+func ensureToken(term terminal.Terminal, cl client.Client) {
+	if len(token) > 0 {
+		return
+	}
+	var err error
+	token, err = auth.GetTokenFromOC()
+	if err != nil {
+		tokenRequestURI, err := auth.GetTokenRequestURI(cl)
+		errMsg := "a token is required to capture metrics, use oc login with token to log into the cluster. eg. `oc login --token=<token> --server=<server>`"
+		if err != nil {
+			term.Fatalf(err, errMsg)
+		}
+		term.Fatalf(fmt.Errorf("a token can be requested from %s", tokenRequestURI), errMsg)
+	}
+}
+
+func validateWorkloads(term terminal.Terminal) {
+	for _, w := range workloads {
+		pair := strings.Split(w, ":")
+		if len(pair) != 2 {
+			term.Fatalf(fmt.Errorf("invalid workload '%s'", w), "invalid workloads values provided '%v' - values must be namespace:name pairs", workloads)
+		}
+	}
+}
+
+// installTemplatePaths returns the paths of the install templates of the given number of operators
+func installTemplatePaths(limit int) []string {
+	templatePaths := []string{}
+	for i := 0; i < limit; i++ {
+		templatePaths = append(templatePaths, "setup/operators/installtemplates/"+operators.Templates[i])
+	}
+	return templatePaths
+}
+
+// metadataNamespaces returns the namespaces in which the metadata of the installed operators are collected: the namespaces of
+// the Subscriptions of all the install templates (the operators may have been installed by a previous run) and the namespaces
+// of the custom workloads
+func metadataNamespaces(term terminal.Terminal, s *runtime.Scheme) []string {
+	namespaces, err := operators.SubscriptionNamespaces(s, installTemplatePaths(len(operators.Templates)))
+	if err != nil {
+		term.Errorf(err, "unable to get the namespaces of the onboarding operators")
+	}
+	for _, w := range workloads {
+		namespaces = append(namespaces, strings.Split(w, ":")[0])
+	}
+	return namespaces
+}
+
+// addWorkloadQueries adds the CPU and memory queries for each custom workload
+func addWorkloadQueries(term terminal.Terminal, cl client.Client, g *metrics.Gatherer, prometheusClient prometheus.API) {
+	for _, w := range workloads {
+		pair := strings.Split(w, ":")
+		if err := cl.Get(context.TODO(), types.NamespacedName{Namespace: pair[0], Name: pair[1]}, &appsv1.Deployment{}); err != nil {
+			term.Fatalf(err, "invalid workload provided '%s'", w)
+		}
+		g.AddQueries(
+			queries.QueryWorkloadCPUUsage(prometheusClient, pair[0], pair[1]),
+			queries.QueryWorkloadMemoryUsage(prometheusClient, pair[0], pair[1]),
+		)
+	}
+}
+
 func usersWithinBounds(term terminal.Terminal, value int, templateType string) {
 	if value < 0 || value > numberOfUsers {
 		term.Fatalf(fmt.Errorf("value must be between 0 and %d", numberOfUsers), "invalid '%s' users value '%d'", templateType, value)
@@ -11,12 +11,16 @@ import (
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
 	"github.com/codeready-toolchain/toolchain-e2e/setup/terminal"
 
+	apiserverv1 "github.com/openshift/api/apiserver/v1"
+	configv1 "github.com/openshift/api/config/v1"
 	quotav1 "github.com/openshift/api/quota/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	templatev1 "github.com/openshift/api/template/v1"
 	operatorsv1 "github.com/operator-framework/api/pkg/operators/v1"
 	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
@@ -116,6 +120,10 @@ func NewScheme() (*runtime.Scheme, error) {
 		templatev1.Install,
 		routev1.Install,
 		appsv1.AddToScheme,
+		corev1.AddToScheme,
+		batchv1.AddToScheme,
+		apiserverv1.Install,
+		configv1.Install,
 	)
 	err := builder.AddToScheme(s)
 	return s, err
@@ -136,6 +144,15 @@ func ConfigureDefaultSpaceTier(cl client.Client) error {
 	return cl.Update(context.TODO(), toolchainCfg)
 }
 
+// ClusterVersion returns the current version of the OpenShift cluster
+func ClusterVersion(cl client.Client) (string, error) {
+	clusterVersion := &configv1.ClusterVersion{}
+	if err := cl.Get(context.TODO(), types.NamespacedName{Name: "version"}, clusterVersion); err != nil {
+		return "", err
+	}
+	return clusterVersion.Status.Desired.Version, nil
+}
+
 // DisableCopiedCSVs disables OLM's CopiedCSVs feature, since OpenShift 4.13 the console no longer relies on CSVs to know which operators are installed
 func DisableCopiedCSVs(cl client.Client) error {
 	olmConfig := &operatorsv1.OLMConfig{}
@@ -186,6 +203,11 @@ func ResultsDir() string {
 	return resultsDir
 }
 
+// HistoryDir returns the directory of the run history, which is shared by all the runs
+func HistoryDir() string {
+	return resultsDir + "history/"
+}
+
 func ResultsFilepath() string {
 	return resultsFilepath
 }
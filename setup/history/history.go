@@ -0,0 +1,122 @@
+package history
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Run is a single entry of the run history, it contains the results of a setup run along with
+// the context that is needed to compare it with other runs
+type Run struct {
+	Testname       string `json:"testname"`
+	StartedAt      string `json:"startedAt"`
+	ClusterVersion string `json:"clusterVersion"`
+	// ClusterNodes are the instance types of the nodes of the cluster, eg. "m5.2xlarge x3, m5.4xlarge x6"
+	ClusterNodes     string            `json:"clusterNodes"`
+	OperatorVersions map[string]string `json:"operatorVersions"`
+	// Workload contains the values of the flags which shape the workload of the run, keyed by flag name
+	Workload map[string]string `json:"workload"`
+	Results  []Result          `json:"results"`
+}
+
+// Result is a single item of the results of a run, eg. "Average Cluster CPU Utilisation (%)"
+type Result struct {
+	Item  string `json:"item"`
+	Value string `json:"value"`
+}
+
+// Key identifies the runs that can be compared with each other: runs with the same testname that were executed
+// against the same cluster and operator versions with the same workload shape
+func (r Run) Key() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s;%s", r.ClusterVersion, r.ClusterNodes)
+	for _, k := range sortedKeys(r.OperatorVersions) {
+		fmt.Fprintf(&b, ";%s=%s", k, r.OperatorVersions[k])
+	}
+	for _, k := range sortedKeys(r.Workload) {
+		fmt.Fprintf(&b, ";--%s=%s", k, r.Workload[k])
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return fmt.Sprintf("%s-%x", strings.TrimPrefix(r.Testname, "-"), sum[:6])
+}
+
+// Store is an append-only directory of JSON files, one per run
+type Store struct {
+	dir string
+}
+
+// NewStore returns a store that keeps the run history in the given directory
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Append adds the given run to the history. Existing entries are never modified.
+func (s *Store) Append(run Run) (string, error) {
+	if err := os.MkdirAll(s.dir, os.ModePerm); err != nil {
+		return "", fmt.Errorf("unable to create the run history directory %s: %w", s.dir, err)
+	}
+	data, err := json.MarshalIndent(run, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(s.dir, fmt.Sprintf("%s%s.json", run.StartedAt, run.Testname))
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return "", fmt.Errorf("unable to add the run to the history: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(data); err != nil {
+		return "", fmt.Errorf("unable to add the run to the history: %w", err)
+	}
+	return path, nil
+}
+
+// Load returns all the runs of the history, ordered by their start time
+func (s *Store) Load() ([]Run, error) {
+	files, err := filepath.Glob(filepath.Join(s.dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	runs := make([]Run, 0, len(files))
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		run := Run{}
+		if err := json.Unmarshal(data, &run); err != nil {
+			return nil, fmt.Errorf("invalid run history file %s: %w", file, err)
+		}
+		runs = append(runs, run)
+	}
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].StartedAt < runs[j].StartedAt
+	})
+	return runs, nil
+}
+
+// NewResults converts the results rows of a run into history results, skipping the header row
+func NewResults(rows [][]string) []Result {
+	results := make([]Result, 0, len(rows))
+	for _, row := range rows {
+		if len(row) < 2 || (row[0] == "Item" && row[1] == "Value") {
+			continue
+		}
+		results = append(results, Result{Item: row[0], Value: row[1]})
+	}
+	return results
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
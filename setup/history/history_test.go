@@ -0,0 +1,184 @@
+package history
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	t.Run("append and load", func(t *testing.T) {
+		// given
+		store := NewStore(t.TempDir())
+		second := newRun("-perf", "2024-01-02_10:00:00", "4.15.3", "10.00")
+		first := newRun("-perf", "2024-01-01_10:00:00", "4.15.3", "20.00")
+
+		// when
+		_, err := store.Append(second)
+		require.NoError(t, err)
+		_, err = store.Append(first)
+		require.NoError(t, err)
+		runs, err := store.Load()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []Run{first, second}, runs)
+	})
+
+	t.Run("existing entries are not overwritten", func(t *testing.T) {
+		// given
+		store := NewStore(t.TempDir())
+		run := newRun("-perf", "2024-01-01_10:00:00", "4.15.3", "20.00")
+		_, err := store.Append(run)
+		require.NoError(t, err)
+
+		// when
+		_, err = store.Append(run)
+
+		// then
+		require.ErrorContains(t, err, "unable to add the run to the history")
+	})
+
+	t.Run("empty history", func(t *testing.T) {
+		// when
+		runs, err := NewStore(t.TempDir() + "/missing").Load()
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, runs)
+	})
+}
+
+func TestNewResults(t *testing.T) {
+	// when
+	results := NewResults([][]string{
+		{"Item", "Value"},
+		{"Number of Users", "2000"},
+	})
+
+	// then
+	assert.Equal(t, []Result{{Item: "Number of Users", Value: "2000"}}, results)
+}
+
+func TestRunKey(t *testing.T) {
+	// given
+	run := newRun("-perf", "2024-01-01_10:00:00", "4.15.3", "20.00")
+	otherNodes := newRun("-perf", "2024-01-01_10:00:00", "4.15.3", "20.00")
+	otherNodes.ClusterNodes = "m5.4xlarge x3"
+	otherOperators := newRun("-perf", "2024-01-01_10:00:00", "4.15.3", "20.00")
+	otherOperators.OperatorVersions = map[string]string{"host-operator": "toolchain-host-operator.v0.0.2"}
+	otherWorkload := newRun("-perf", "2024-01-01_10:00:00", "4.15.3", "20.00")
+	otherWorkload.Workload = map[string]string{"activity-rate": "0.01"}
+
+	// then
+	assert.Equal(t, run.Key(), newRun("perf", "2024-01-02_10:00:00", "4.15.3", "10.00").Key())
+	assert.NotEqual(t, run.Key(), otherNodes.Key())
+	assert.NotEqual(t, run.Key(), otherOperators.Key())
+	assert.NotEqual(t, run.Key(), otherWorkload.Key())
+}
+
+func TestNewGroups(t *testing.T) {
+	// given
+	run1 := newRun("-perf", "2024-01-01_10:00:00", "4.15.3", "20.00")
+	run2 := newRun("-perf", "2024-01-02_10:00:00", "4.15.3", "10.00")
+	run3 := newRun("-perf", "2024-01-03_10:00:00", "4.16.0", "15.00")
+	run4 := newRun("-other", "2024-01-04_10:00:00", "4.15.3", "30.00")
+	run2.Results = append(run2.Results, Result{Item: "Max Cluster CPU Utilisation (%)", Value: "50.00"})
+
+	t.Run("grouped by key", func(t *testing.T) {
+		// when
+		groups := NewGroups([]Run{run1, run2, run3, run4}, "", "")
+
+		// then
+		require.Len(t, groups, 3)
+		assert.Equal(t, []Run{run1, run2}, groups[0].Runs)
+		assert.Equal(t, []Trend{
+			{Item: "Average Cluster CPU Utilisation (%)", Values: []string{"20.00", "10.00"}},
+			{Item: "Max Cluster CPU Utilisation (%)", Values: []string{"", "50.00"}},
+		}, groups[0].Trends)
+		assert.Equal(t, []Run{run3}, groups[1].Runs)
+		assert.Equal(t, []Run{run4}, groups[2].Runs)
+	})
+
+	t.Run("filtered by testname and metric", func(t *testing.T) {
+		// when
+		groups := NewGroups([]Run{run1, run2, run3, run4}, "perf", "max cluster")
+
+		// then
+		require.Len(t, groups, 2)
+		assert.Equal(t, []Trend{
+			{Item: "Max Cluster CPU Utilisation (%)", Values: []string{"", "50.00"}},
+		}, groups[0].Trends)
+		assert.Empty(t, groups[1].Trends)
+	})
+}
+
+func TestWriteReports(t *testing.T) {
+	// given
+	groups := NewGroups([]Run{
+		newRun("-perf", "2024-01-01_10:00:00", "4.15.3", "20.00"),
+		newRun("-perf", "2024-01-02_10:00:00", "4.15.3", "10.00"),
+	}, "", "")
+
+	t.Run("tables", func(t *testing.T) {
+		// given
+		out := &bytes.Buffer{}
+
+		// when
+		err := WriteTables(out, groups)
+
+		// then
+		require.NoError(t, err)
+		assert.Contains(t, out.String(), "(cluster version: 4.15.3)")
+		assert.Regexp(t, `Average Cluster CPU Utilisation \(%\)\s+20.00\s+10.00`, out.String())
+	})
+
+	t.Run("html", func(t *testing.T) {
+		// given
+		out := &bytes.Buffer{}
+
+		// when
+		err := WriteHTML(out, groups)
+
+		// then
+		require.NoError(t, err)
+		assert.Contains(t, out.String(), `<polyline points="0.0,0.0 400.0,100.0"/>`)
+	})
+}
+
+func TestChartPoints(t *testing.T) {
+	t.Run("numeric values", func(t *testing.T) {
+		// when
+		points, minVal, maxVal, ok := chartPoints([]string{"10", "", "30"})
+
+		// then
+		require.True(t, ok)
+		assert.Equal(t, "0.0,100.0 400.0,0.0", points)
+		assert.InDelta(t, 10, minVal, 0.01)
+		assert.InDelta(t, 30, maxVal, 0.01)
+	})
+
+	t.Run("no numeric value", func(t *testing.T) {
+		// when
+		_, _, _, ok := chartPoints([]string{"", "n/a"})
+
+		// then
+		require.False(t, ok)
+	})
+}
+
+func newRun(testname, startedAt, clusterVersion, cpu string) Run {
+	return Run{
+		Testname:         testname,
+		StartedAt:        startedAt,
+		ClusterVersion:   clusterVersion,
+		ClusterNodes:     "m5.2xlarge x3",
+		OperatorVersions: map[string]string{"host-operator": "toolchain-host-operator.v0.0.1"},
+		Workload:         map[string]string{"activity-rate": "0"},
+		Results: []Result{
+			{Item: "Average Cluster CPU Utilisation (%)", Value: cpu},
+		},
+	}
+}
@@ -0,0 +1,217 @@
+package history
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/gosuri/uitable"
+)
+
+// Group contains the runs sharing the same key along with the trend of each of their results
+type Group struct {
+	Key    string
+	Runs   []Run
+	Trends []Trend
+}
+
+// Trend is the evolution of a single result item across the runs of a group
+type Trend struct {
+	Item string
+	// Values contains one value per run of the group, runs that don't have a value for the item have an empty value
+	Values []string
+}
+
+// NewGroups groups the given runs by key and computes the trend of each result item.
+// The runs can be restricted to a testname and the items to those containing the given metric filter.
+func NewGroups(runs []Run, testname, metric string) []Group {
+	var groups []*Group
+	byKey := map[string]*Group{}
+	for _, run := range runs {
+		if testname != "" && strings.TrimPrefix(run.Testname, "-") != strings.TrimPrefix(testname, "-") {
+			continue
+		}
+		g, ok := byKey[run.Key()]
+		if !ok {
+			g = &Group{Key: run.Key()}
+			byKey[run.Key()] = g
+			groups = append(groups, g)
+		}
+		g.Runs = append(g.Runs, run)
+	}
+
+	result := make([]Group, 0, len(groups))
+	for _, g := range groups {
+		trends := map[string]*Trend{}
+		var items []string
+		for i, run := range g.Runs {
+			for _, r := range run.Results {
+				if metric != "" && !strings.Contains(strings.ToLower(r.Item), strings.ToLower(metric)) {
+					continue
+				}
+				t, ok := trends[r.Item]
+				if !ok {
+					t = &Trend{Item: r.Item, Values: make([]string, len(g.Runs))}
+					trends[r.Item] = t
+					items = append(items, r.Item)
+				}
+				t.Values[i] = r.Value
+			}
+		}
+		for _, item := range items {
+			g.Trends = append(g.Trends, *trends[item])
+		}
+		result = append(result, *g)
+	}
+	return result
+}
+
+// WriteTables writes a trend table per group
+func WriteTables(w io.Writer, groups []Group) error {
+	for _, g := range groups {
+		table := uitable.New()
+		table.MaxColWidth = 60
+		table.Wrap = true
+
+		header := []interface{}{"Metric"}
+		for _, run := range g.Runs {
+			header = append(header, run.StartedAt)
+		}
+		table.AddRow(header...)
+		for _, t := range g.Trends {
+			row := []interface{}{t.Item}
+			for _, v := range t.Values {
+				if v == "" {
+					v = "-"
+				}
+				row = append(row, v)
+			}
+			table.AddRow(row...)
+		}
+		if _, err := fmt.Fprintf(w, "\n%s (cluster version: %s)\n%s\n", g.Key, clusterVersion(g), table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteHTML writes a page with a line chart per metric and group
+func WriteHTML(w io.Writer, groups []Group) error {
+	tmpl, err := template.New("history").Parse(htmlTemplate)
+	if err != nil {
+		return err
+	}
+
+	type chart struct {
+		Item   string
+		Points string
+		Min    string
+		Max    string
+	}
+	type section struct {
+		Key            string
+		ClusterVersion string
+		Runs           []Run
+		Charts         []chart
+	}
+	sections := make([]section, 0, len(groups))
+	for _, g := range groups {
+		s := section{Key: g.Key, ClusterVersion: clusterVersion(g), Runs: g.Runs}
+		for _, t := range g.Trends {
+			points, minVal, maxVal, ok := chartPoints(t.Values)
+			if !ok {
+				continue
+			}
+			s.Charts = append(s.Charts, chart{
+				Item:   t.Item,
+				Points: points,
+				Min:    strconv.FormatFloat(minVal, 'f', -1, 64),
+				Max:    strconv.FormatFloat(maxVal, 'f', -1, 64),
+			})
+		}
+		sections = append(sections, s)
+	}
+	return tmpl.Execute(w, sections)
+}
+
+const (
+	chartWidth  = 400
+	chartHeight = 100
+)
+
+// chartPoints converts the numeric values of a trend to the points of an SVG polyline.
+// It returns false if the trend doesn't contain any numeric value.
+func chartPoints(values []string) (string, float64, float64, bool) {
+	type point struct {
+		index int
+		value float64
+	}
+	var points []point
+	for i, v := range values {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			continue
+		}
+		points = append(points, point{index: i, value: f})
+	}
+	if len(points) == 0 {
+		return "", 0, 0, false
+	}
+
+	minVal, maxVal := points[0].value, points[0].value
+	for _, p := range points {
+		minVal = min(minVal, p.value)
+		maxVal = max(maxVal, p.value)
+	}
+	xStep := float64(chartWidth)
+	if len(values) > 1 {
+		xStep = float64(chartWidth) / float64(len(values)-1)
+	}
+	coords := make([]string, 0, len(points))
+	for _, p := range points {
+		y := float64(chartHeight) / 2
+		if maxVal > minVal {
+			y = float64(chartHeight) - (p.value-minVal)/(maxVal-minVal)*float64(chartHeight)
+		}
+		coords = append(coords, fmt.Sprintf("%.1f,%.1f", float64(p.index)*xStep, y))
+	}
+	return strings.Join(coords, " "), minVal, maxVal, true
+}
+
+func clusterVersion(g Group) string {
+	if len(g.Runs) == 0 || g.Runs[0].ClusterVersion == "" {
+		return "unknown"
+	}
+	return g.Runs[0].ClusterVersion
+}
+
+const htmlTemplate = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Setup run history</title>
+<style>
+body { font-family: sans-serif; }
+.chart { display: inline-block; margin: 10px; }
+svg { border: 1px solid #ccc; overflow: visible; }
+polyline { fill: none; stroke: #06c; stroke-width: 2; }
+</style>
+</head>
+<body>
+<h1>Setup run history</h1>
+{{ range . }}
+<h2>{{ .Key }}</h2>
+<p>Cluster version: {{ .ClusterVersion }}, runs: {{ range $i, $r := .Runs }}{{ if $i }}, {{ end }}{{ $r.StartedAt }}{{ end }}</p>
+{{ range .Charts }}
+<div class="chart">
+<h4>{{ .Item }}</h4>
+<svg width="400" height="100"><polyline points="{{ .Points }}"/></svg>
+<p>min: {{ .Min }}, max: {{ .Max }}</p>
+</div>
+{{ end }}
+{{ end }}
+</body>
+</html>
+`
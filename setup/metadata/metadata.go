@@ -0,0 +1,236 @@
+package metadata
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"runtime/debug"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	cfg "github.com/codeready-toolchain/toolchain-e2e/setup/configuration"
+	"github.com/codeready-toolchain/toolchain-e2e/setup/operators"
+	"github.com/codeready-toolchain/toolchain-e2e/setup/terminal"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	unknown = "unknown"
+
+	instanceTypeLabel = "node.kubernetes.io/instance-type"
+)
+
+// Metadata is the context of a run, it is needed to compare the results of different runs
+type Metadata struct {
+	OpenShiftVersion  string
+	KubernetesVersion string
+	NodeCount         int
+	// InstanceTypes contains the number of nodes per instance type
+	InstanceTypes       map[string]int
+	SandboxOperators    []Operator
+	OnboardingOperators []Operator
+	ToolchainConfigHash string
+	SetupCommit         string
+}
+
+// Operator is an operator installed via a Subscription
+type Operator struct {
+	Name string
+	CSV  string
+	// Commit is only available for the sandbox operators
+	Commit string
+}
+
+// Collect gathers the metadata of the cluster and of the operators installed in the sandbox namespaces and in the given namespaces
+// (ie, the ones of the onboarding operators and of the workloads).
+// The values which cannot be retrieved are reported in the terminal and set to 'unknown' since the metadata is informational only.
+func Collect(term terminal.Terminal, cl client.Client, config *rest.Config, namespaces []string) Metadata {
+	m := Metadata{
+		OpenShiftVersion:    unknown,
+		KubernetesVersion:   unknown,
+		ToolchainConfigHash: unknown,
+		InstanceTypes:       map[string]int{},
+	}
+
+	if v, err := cfg.ClusterVersion(cl); err != nil {
+		term.Errorf(err, "unable to get the OpenShift version")
+	} else {
+		m.OpenShiftVersion = v
+	}
+
+	if v, err := kubernetesVersion(config); err != nil {
+		term.Errorf(err, "unable to get the Kubernetes version")
+	} else {
+		m.KubernetesVersion = v
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := cl.List(context.TODO(), nodes); err != nil {
+		term.Errorf(err, "unable to list the nodes")
+	} else {
+		m.NodeCount = len(nodes.Items)
+		for _, n := range nodes.Items {
+			instanceType, ok := n.Labels[instanceTypeLabel]
+			if !ok {
+				instanceType = unknown
+			}
+			m.InstanceTypes[instanceType]++
+		}
+	}
+
+	toolchainStatus := &toolchainv1alpha1.ToolchainStatus{}
+	if err := cl.Get(context.TODO(), types.NamespacedName{Name: "toolchain-status", Namespace: cfg.HostOperatorNamespace}, toolchainStatus); err != nil {
+		term.Errorf(err, "unable to get the ToolchainStatus")
+	}
+	for _, ns := range subscriptionNamespaces(namespaces) {
+		subs := &v1alpha1.SubscriptionList{}
+		if err := cl.List(context.TODO(), subs, client.InNamespace(ns)); err != nil {
+			term.Errorf(err, "unable to list the subscriptions in the '%s' namespace", ns)
+			continue
+		}
+		for _, sub := range subs.Items {
+			op := Operator{Name: sub.Name, CSV: sub.Status.InstalledCSV}
+			if operators.IsSandboxOperator(sub.Name) {
+				op.Commit = unknown
+				if commit, err := operatorCommit(toolchainStatus, sub.Name); err != nil {
+					term.Errorf(err, "unable to get the commit of the '%s' operator", sub.Name)
+				} else {
+					op.Commit = commit
+				}
+				m.SandboxOperators = append(m.SandboxOperators, op)
+			} else {
+				m.OnboardingOperators = append(m.OnboardingOperators, op)
+			}
+		}
+	}
+
+	if h, err := toolchainConfigHash(cl); err != nil {
+		term.Errorf(err, "unable to compute the hash of the ToolchainConfig")
+	} else {
+		m.ToolchainConfigHash = h
+	}
+
+	m.SetupCommit = setupCommit()
+	return m
+}
+
+// Rows returns the metadata as results rows
+func (m Metadata) Rows() [][]string {
+	rows := [][]string{
+		{"OpenShift Version", m.OpenShiftVersion},
+		{"Kubernetes Version", m.KubernetesVersion},
+		{"Node Count", strconv.Itoa(m.NodeCount)},
+		{"Node Instance Types", m.ClusterNodes()},
+	}
+	for _, op := range m.SandboxOperators {
+		rows = append(rows,
+			[]string{fmt.Sprintf("Sandbox Operator %s CSV", op.Name), op.CSV},
+			[]string{fmt.Sprintf("Sandbox Operator %s Commit", op.Name), op.Commit},
+		)
+	}
+	for _, op := range m.OnboardingOperators {
+		rows = append(rows, []string{fmt.Sprintf("Onboarding Operator %s CSV", op.Name), op.CSV})
+	}
+	return append(rows,
+		[]string{"ToolchainConfig Hash", m.ToolchainConfigHash},
+		[]string{"Setup Tool Commit", m.SetupCommit},
+	)
+}
+
+// OperatorVersions returns the CSV and commit of the sandbox operators, keyed by the subscription name
+func (m Metadata) OperatorVersions() map[string]string {
+	versions := make(map[string]string, len(m.SandboxOperators))
+	for _, op := range m.SandboxOperators {
+		versions[op.Name] = fmt.Sprintf("%s (%s)", op.CSV, op.Commit)
+	}
+	return versions
+}
+
+// ClusterNodes returns the instance types of the nodes of the cluster along with their count, eg. "m5.2xlarge x3, m5.4xlarge x6"
+func (m Metadata) ClusterNodes() string {
+	types := make([]string, 0, len(m.InstanceTypes))
+	for t, count := range m.InstanceTypes {
+		types = append(types, fmt.Sprintf("%s x%d", t, count))
+	}
+	sort.Strings(types)
+	return strings.Join(types, ", ")
+}
+
+func kubernetesVersion(config *rest.Config) (string, error) {
+	dc, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return "", err
+	}
+	v, err := dc.ServerVersion()
+	if err != nil {
+		return "", err
+	}
+	return v.GitVersion, nil
+}
+
+// subscriptionNamespaces returns the sandbox operator namespaces followed by the given namespaces, without duplicates
+func subscriptionNamespaces(namespaces []string) []string {
+	result := []string{cfg.HostOperatorNamespace, cfg.MemberOperatorNamespace}
+	for _, ns := range namespaces {
+		if !slices.Contains(result, ns) {
+			result = append(result, ns)
+		}
+	}
+	return result
+}
+
+// operatorCommit returns the commit of the given sandbox operator, as reported in the ToolchainStatus
+func operatorCommit(toolchainStatus *toolchainv1alpha1.ToolchainStatus, subscriptionName string) (string, error) {
+	if !operators.IsMemberOperator(subscriptionName) {
+		if toolchainStatus.Status.HostOperator == nil || toolchainStatus.Status.HostOperator.Revision == "" {
+			return "", fmt.Errorf("the ToolchainStatus does not contain the revision of the host operator")
+		}
+		return toolchainStatus.Status.HostOperator.Revision, nil
+	}
+	for _, member := range toolchainStatus.Status.Members {
+		if member.MemberStatus.MemberOperator != nil && member.MemberStatus.MemberOperator.Revision != "" {
+			return member.MemberStatus.MemberOperator.Revision, nil
+		}
+	}
+	return "", fmt.Errorf("the ToolchainStatus does not contain the revision of the member operator")
+}
+
+// toolchainConfigHash returns the SHA-256 of the spec of the ToolchainConfig
+func toolchainConfigHash(cl client.Client) (string, error) {
+	toolchainCfg := &toolchainv1alpha1.ToolchainConfig{}
+	if err := cl.Get(context.TODO(), types.NamespacedName{Name: "config", Namespace: cfg.HostOperatorNamespace}, toolchainCfg); err != nil {
+		return "", err
+	}
+	spec, err := json.Marshal(toolchainCfg.Spec)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(spec)), nil
+}
+
+// setupCommit returns the commit of the setup tool, either from the build info or from the local git repository
+func setupCommit() string {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, s := range info.Settings {
+			if s.Key == "vcs.revision" {
+				return s.Value
+			}
+		}
+	}
+	out, err := exec.Command("git", "rev-parse", "HEAD").Output()
+	if err != nil {
+		return unknown
+	}
+	return strings.TrimSpace(string(out))
+}
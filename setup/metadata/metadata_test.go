@@ -0,0 +1,150 @@
+package metadata
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	cfg "github.com/codeready-toolchain/toolchain-e2e/setup/configuration"
+	"github.com/codeready-toolchain/toolchain-e2e/setup/terminal"
+	"github.com/codeready-toolchain/toolchain-e2e/setup/test"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestCollect(t *testing.T) {
+	// given
+	cfg.HostOperatorNamespace = cfg.DefaultHostNS
+	cfg.MemberOperatorNamespace = cfg.DefaultMemberNS
+	objs := []client.Object{
+		&configv1.ClusterVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: "version"},
+			Status:     configv1.ClusterVersionStatus{Desired: configv1.Release{Version: "4.15.3"}},
+		},
+		node("master-0", "m5.8xlarge"),
+		node("master-1", "m5.8xlarge"),
+		node("worker-0", "m5.2xlarge"),
+		subscription("host-operator", "toolchain-host-operator", "toolchain-host-operator.v0.0.1"),
+		subscription("member-operator", "toolchain-member-operator", "toolchain-member-operator.v0.0.2"),
+		subscription("kiali-ossm", "openshift-operators", "kiali-operator.v1.24.7"),
+		subscription("devspaces", "openshift-devspaces", "devspacesoperator.v3.12.0"), // not in a workload namespace
+		&toolchainv1alpha1.ToolchainStatus{
+			ObjectMeta: metav1.ObjectMeta{Name: "toolchain-status", Namespace: cfg.DefaultHostNS},
+			Status: toolchainv1alpha1.ToolchainStatusStatus{
+				HostOperator: &toolchainv1alpha1.HostOperatorStatus{Revision: "abcd1234"},
+				Members: []toolchainv1alpha1.Member{
+					{ClusterName: "member-cluster", MemberStatus: toolchainv1alpha1.MemberStatusStatus{
+						MemberOperator: &toolchainv1alpha1.MemberOperatorStatus{Revision: "efgh5678"},
+					}},
+				},
+			},
+		},
+		&toolchainv1alpha1.ToolchainConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: cfg.DefaultHostNS},
+		},
+	}
+	cl := test.NewFakeClient(t, objs...)
+	out := &bytes.Buffer{}
+	term := terminal.New(func() io.Reader { return strings.NewReader("") }, func() io.Writer { return out }, false)
+
+	// when
+	m := Collect(term, cl, &rest.Config{Host: "http://127.0.0.1:0"}, []string{"openshift-operators"})
+
+	// then
+	assert.Equal(t, "4.15.3", m.OpenShiftVersion)
+	assert.Equal(t, unknown, m.KubernetesVersion) // no API server to query
+	assert.Equal(t, 3, m.NodeCount)
+	assert.Equal(t, map[string]int{"m5.8xlarge": 2, "m5.2xlarge": 1}, m.InstanceTypes)
+	assert.ElementsMatch(t, []Operator{
+		{Name: "host-operator", CSV: "toolchain-host-operator.v0.0.1", Commit: "abcd1234"},
+		{Name: "member-operator", CSV: "toolchain-member-operator.v0.0.2", Commit: "efgh5678"},
+	}, m.SandboxOperators)
+	assert.Equal(t, []Operator{{Name: "kiali-ossm", CSV: "kiali-operator.v1.24.7"}}, m.OnboardingOperators)
+	assert.Len(t, m.ToolchainConfigHash, 64)
+	assert.NotEmpty(t, m.SetupCommit)
+	assert.NotContains(t, out.String(), "unable to get the commit")
+}
+
+func TestCollectWithoutToolchainStatus(t *testing.T) {
+	// given
+	cfg.HostOperatorNamespace = cfg.DefaultHostNS
+	cfg.MemberOperatorNamespace = cfg.DefaultMemberNS
+	cl := test.NewFakeClient(t,
+		subscription("host-operator", "toolchain-host-operator", "toolchain-host-operator.v0.0.1"),
+		subscription("member-operator", "toolchain-member-operator", "toolchain-member-operator.v0.0.2"),
+	)
+	out := &bytes.Buffer{}
+	term := terminal.New(func() io.Reader { return strings.NewReader("") }, func() io.Writer { return out }, false)
+
+	// when
+	m := Collect(term, cl, &rest.Config{Host: "http://127.0.0.1:0"}, nil)
+
+	// then
+	assert.ElementsMatch(t, []Operator{
+		{Name: "host-operator", CSV: "toolchain-host-operator.v0.0.1", Commit: unknown},
+		{Name: "member-operator", CSV: "toolchain-member-operator.v0.0.2", Commit: unknown},
+	}, m.SandboxOperators)
+	assert.Empty(t, m.OnboardingOperators)
+	assert.Contains(t, out.String(), "unable to get the ToolchainStatus")
+	assert.Contains(t, out.String(), "unable to get the commit of the 'member-operator' operator")
+}
+
+func TestRows(t *testing.T) {
+	// given
+	m := Metadata{
+		OpenShiftVersion:    "4.15.3",
+		KubernetesVersion:   "v1.28.6",
+		NodeCount:           3,
+		InstanceTypes:       map[string]int{"m5.8xlarge": 2, "m5.2xlarge": 1},
+		SandboxOperators:    []Operator{{Name: "host-operator", CSV: "toolchain-host-operator.v0.0.1", Commit: "abcd1234"}},
+		OnboardingOperators: []Operator{{Name: "kiali-ossm", CSV: "kiali-operator.v1.24.7"}},
+		ToolchainConfigHash: "0123",
+		SetupCommit:         "4567",
+	}
+
+	// when
+	rows := m.Rows()
+
+	// then
+	assert.Equal(t, [][]string{
+		{"OpenShift Version", "4.15.3"},
+		{"Kubernetes Version", "v1.28.6"},
+		{"Node Count", "3"},
+		{"Node Instance Types", "m5.2xlarge x1, m5.8xlarge x2"},
+		{"Sandbox Operator host-operator CSV", "toolchain-host-operator.v0.0.1"},
+		{"Sandbox Operator host-operator Commit", "abcd1234"},
+		{"Onboarding Operator kiali-ossm CSV", "kiali-operator.v1.24.7"},
+		{"ToolchainConfig Hash", "0123"},
+		{"Setup Tool Commit", "4567"},
+	}, rows)
+	assert.Equal(t, map[string]string{"host-operator": "toolchain-host-operator.v0.0.1 (abcd1234)"}, m.OperatorVersions())
+}
+
+func node(name, instanceType string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{instanceTypeLabel: instanceType},
+		},
+	}
+}
+
+func subscription(name, namespace, csv string) *v1alpha1.Subscription {
+	return &v1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Status: v1alpha1.SubscriptionStatus{
+			InstalledCSV: csv,
+		},
+	}
+}
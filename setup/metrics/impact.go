@@ -0,0 +1,336 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	cfg "github.com/codeready-toolchain/toolchain-e2e/setup/configuration"
+	"github.com/codeready-toolchain/toolchain-e2e/setup/metrics/queries"
+	"github.com/codeready-toolchain/toolchain-e2e/setup/terminal"
+
+	apiserverv1 "github.com/openshift/api/apiserver/v1"
+	prometheus "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Phase identifies the point of the run at which a control plane snapshot was captured
+type Phase string
+
+const (
+	BeforeProvisioning Phase = "Before"
+	AfterProvisioning  Phase = "After"
+)
+
+// snapshotSource returns values keyed by a description of the labels they were captured for, eg. "verb=get, resource=spaces"
+type snapshotSource interface {
+	Name() string
+	Capture() (map[string]float64, error)
+}
+
+// windowSource returns values aggregated over the provisioning, eg. the rate of the requests made by the operators.
+// It is started when the state before provisioning is captured and captured with the state after provisioning.
+type windowSource interface {
+	Name() string
+	Start() error
+	Capture() (map[string]float64, error)
+}
+
+// ControlPlaneImpact captures the state of the Kubernetes API server and etcd before and after provisioning, so that the
+// impact of the sandbox on the control plane can be reported
+type ControlPlaneImpact struct {
+	sources       []snapshotSource
+	windowSources []windowSource
+	snapshots     map[Phase]map[string]map[string]float64
+	started       map[string]bool
+	windows       map[string]map[string]float64
+	term          terminal.Terminal
+}
+
+// NewControlPlaneImpact creates a new control plane impact report with the default sources:
+// etcd object counts, API request rates and latencies, APF rejections, watch counts and the requests made by the operators
+func NewControlPlaneImpact(t terminal.Terminal, cl client.Client, prometheusClient prometheus.API) *ControlPlaneImpact {
+	return newControlPlaneImpact(t,
+		[]snapshotSource{
+			breakdownSource{queries.QueryEtcdObjectCounts(prometheusClient)},
+			breakdownSource{queries.QueryAPIRequestRate(prometheusClient)},
+			breakdownSource{queries.QueryAPIRequestLatency(prometheusClient)},
+			breakdownSource{queries.QueryAPFRejectedRequests(prometheusClient)},
+			breakdownSource{queries.QueryWatchCounts(prometheusClient)},
+		},
+		[]windowSource{
+			&operatorRequestsSource{
+				k8sClient: cl,
+				operators: map[string]string{
+					"host-operator":   cfg.HostOperatorNamespace,
+					"member-operator": cfg.MemberOperatorNamespace,
+				},
+				latency: func(window time.Duration) queries.BreakdownQuery {
+					return queries.QueryAPIRequestMeanLatency(prometheusClient, window)
+				},
+				now: time.Now,
+			},
+		},
+	)
+}
+
+func newControlPlaneImpact(t terminal.Terminal, sources []snapshotSource, windowSources []windowSource) *ControlPlaneImpact {
+	return &ControlPlaneImpact{
+		sources:       sources,
+		windowSources: windowSources,
+		snapshots:     map[Phase]map[string]map[string]float64{},
+		started:       map[string]bool{},
+		windows:       map[string]map[string]float64{},
+		term:          t,
+	}
+}
+
+// Capture takes a snapshot of all the sources for the given phase. Sources that fail are reported but don't stop the run
+// since the report is informational only.
+func (c *ControlPlaneImpact) Capture(phase Phase) {
+	snapshot := make(map[string]map[string]float64, len(c.sources))
+	for _, s := range c.sources {
+		values, err := s.Capture()
+		if err != nil {
+			c.term.Errorf(err, "failed to capture '%s' %s provisioning", s.Name(), strings.ToLower(string(phase)))
+			continue
+		}
+		snapshot[s.Name()] = values
+	}
+	c.snapshots[phase] = snapshot
+
+	for _, s := range c.windowSources {
+		switch phase {
+		case BeforeProvisioning:
+			if err := s.Start(); err != nil {
+				c.term.Errorf(err, "failed to start capturing '%s'", s.Name())
+				continue
+			}
+			c.started[s.Name()] = true
+		case AfterProvisioning:
+			if !c.started[s.Name()] {
+				continue
+			}
+			values, err := s.Capture()
+			if err != nil {
+				c.term.Errorf(err, "failed to capture '%s' during provisioning", s.Name())
+				continue
+			}
+			c.windows[s.Name()] = values
+		}
+	}
+}
+
+// ComputeResults returns the before and after values, as well as the difference between them, for every captured key,
+// followed by the values captured during provisioning
+func (c *ControlPlaneImpact) ComputeResults() [][]string {
+	var tuples [][]string
+	before := c.snapshots[BeforeProvisioning]
+	after := c.snapshots[AfterProvisioning]
+	for _, s := range c.sources {
+		keys := map[string]bool{}
+		for k := range before[s.Name()] {
+			keys[k] = true
+		}
+		for k := range after[s.Name()] {
+			keys[k] = true
+		}
+		for _, k := range sortedKeys(keys) {
+			b, a := before[s.Name()][k], after[s.Name()][k]
+			tuples = append(tuples,
+				[]string{fmt.Sprintf("%s %s [%s]", BeforeProvisioning, s.Name(), k), simple(b)},
+				[]string{fmt.Sprintf("%s %s [%s]", AfterProvisioning, s.Name(), k), simple(a)},
+				[]string{fmt.Sprintf("Delta %s [%s]", s.Name(), k), simple(a - b)},
+			)
+		}
+	}
+	for _, s := range c.windowSources {
+		values := c.windows[s.Name()]
+		for _, k := range sortedKeys(values) {
+			tuples = append(tuples, []string{fmt.Sprintf("%s [%s]", s.Name(), k), simple(values[k])})
+		}
+	}
+	return tuples
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// breakdownSource captures the vector returned by a breakdown query, keyed by the values of the query labels
+type breakdownSource struct {
+	query queries.BreakdownQuery
+}
+
+func (s breakdownSource) Name() string {
+	return s.query.Name()
+}
+
+func (s breakdownSource) Capture() (map[string]float64, error) {
+	val, warnings, err := s.query.Execute()
+	if err != nil {
+		return nil, fmt.Errorf("metrics query failed - check whether prometheus is still healthy in the cluster: %w", err)
+	} else if len(warnings) > 0 {
+		return nil, fmt.Errorf("metrics query had unexpected warnings: %w", fmt.Errorf("warnings: %v", warnings))
+	}
+	vector, ok := val.(model.Vector)
+	if !ok {
+		return nil, fmt.Errorf("metrics query %s returned an unexpected type of value: %s", s.query.Name(), val.Type())
+	}
+
+	values := make(map[string]float64, len(vector))
+	for _, sample := range vector {
+		labels := make([]string, 0, len(s.query.Labels()))
+		for _, l := range s.query.Labels() {
+			labels = append(labels, fmt.Sprintf("%s=%s", l, sample.Metric[model.LabelName(l)]))
+		}
+		values[strings.Join(labels, ", ")] += float64(sample.Value)
+	}
+	return values, nil
+}
+
+// operatorRequest identifies the requests made by an operator with a given verb on a given resource
+type operatorRequest struct {
+	operator string
+	verb     string
+	resource string
+}
+
+// operatorRequestsSource reports the API requests made by the service accounts of the sandbox operators during provisioning,
+// using the OpenShift APIRequestCount resources since the user agent is not available in the API server metrics.
+// The APIRequestCounts don't record the latency, so the latency of the operators is the mean latency of the API server
+// for the verbs and resources they requested, weighted by the number of their requests.
+type operatorRequestsSource struct {
+	k8sClient client.Client
+	// operators maps the name used in the report to the namespace of the operator service accounts
+	operators map[string]string
+	// latency returns the query of the mean latency of the API requests during the given window, by verb and resource
+	latency func(window time.Duration) queries.BreakdownQuery
+	now     func() time.Time
+
+	start time.Time
+	// baseline contains the requests that were already counted in the hour of the start, before the start
+	baseline map[operatorRequest]int64
+}
+
+func (s *operatorRequestsSource) Name() string {
+	return "Operator API Requests"
+}
+
+func (s *operatorRequestsSource) Start() error {
+	s.start = s.now()
+	baseline, err := s.count(hoursOf(s.start, s.start))
+	if err != nil {
+		return err
+	}
+	s.baseline = baseline
+	return nil
+}
+
+func (s *operatorRequestsSource) Capture() (map[string]float64, error) {
+	end := s.now()
+	window := end.Sub(s.start)
+	if window >= 23*time.Hour {
+		return nil, fmt.Errorf("the provisioning took %s but the APIRequestCounts only keep the last 24 hours", window)
+	}
+	requests, err := s.count(hoursOf(s.start, end))
+	if err != nil {
+		return nil, err
+	}
+	for r, count := range s.baseline {
+		requests[r] -= count
+	}
+
+	// the rate of the latency query needs at least a couple of samples
+	latencies, err := breakdownSource{s.latency(max(window, time.Minute))}.Capture()
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]float64{}
+	totals := map[string]int64{}
+	latencyTotals := map[string]float64{}
+	latencyCounts := map[string]int64{}
+	for r, count := range requests {
+		if count <= 0 {
+			continue
+		}
+		values[fmt.Sprintf("user=%s, verb=%s, resource=%s, requests", r.operator, r.verb, r.resource)] = float64(count)
+		totals[r.operator] += count
+		if latency, ok := latencies[fmt.Sprintf("verb=%s, resource=%s", metricsVerb(r.verb), r.resource)]; ok {
+			latencyTotals[r.operator] += latency * float64(count)
+			latencyCounts[r.operator] += count
+		}
+	}
+	for operator, total := range totals {
+		values[fmt.Sprintf("user=%s, requests", operator)] = float64(total)
+		values[fmt.Sprintf("user=%s, rate (req/s)", operator)] = float64(total) / window.Seconds()
+		if latencyCounts[operator] > 0 {
+			values[fmt.Sprintf("user=%s, mean latency (s)", operator)] = latencyTotals[operator] / float64(latencyCounts[operator])
+		}
+	}
+	return values, nil
+}
+
+// count sums the requests made by the operators in the given hours of the day
+func (s *operatorRequestsSource) count(hours map[int]bool) (map[operatorRequest]int64, error) {
+	counts := &apiserverv1.APIRequestCountList{}
+	if err := s.k8sClient.List(context.TODO(), counts); err != nil {
+		return nil, fmt.Errorf("unable to list the APIRequestCounts: %w", err)
+	}
+
+	requests := map[operatorRequest]int64{}
+	for _, count := range counts.Items {
+		// the name of an APIRequestCount is <resource>.<version>.<group>
+		resource := strings.SplitN(count.Name, ".", 2)[0]
+		for hour, hourly := range count.Status.Last24h {
+			if !hours[hour] {
+				continue
+			}
+			for _, node := range hourly.ByNode {
+				for _, user := range node.ByUser {
+					for operator, namespace := range s.operators {
+						if !strings.HasPrefix(user.UserName, fmt.Sprintf("system:serviceaccount:%s:", namespace)) {
+							continue
+						}
+						for _, verb := range user.ByVerb {
+							requests[operatorRequest{operator: operator, verb: verb.Verb, resource: resource}] += verb.RequestCount
+						}
+					}
+				}
+			}
+		}
+	}
+	return requests, nil
+}
+
+// hoursOf returns the hours of the day covered by the given window, which are the indexes of the Last24h buckets of the APIRequestCounts
+func hoursOf(start, end time.Time) map[int]bool {
+	hours := map[int]bool{}
+	for h := start.UTC().Truncate(time.Hour); !h.After(end.UTC()); h = h.Add(time.Hour) {
+		hours[h.Hour()] = true
+	}
+	return hours
+}
+
+// metricsVerb converts the verb of an APIRequestCount to the verb used in the API server metrics
+func metricsVerb(verb string) string {
+	switch verb {
+	case "create":
+		return "POST"
+	case "update":
+		return "PUT"
+	case "deletecollection":
+		return "DELETE"
+	default:
+		return strings.ToUpper(verb)
+	}
+}
@@ -0,0 +1,200 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/toolchain-e2e/setup/metrics/queries"
+	"github.com/codeready-toolchain/toolchain-e2e/setup/terminal"
+	"github.com/codeready-toolchain/toolchain-e2e/setup/test"
+
+	apiserverv1 "github.com/openshift/api/apiserver/v1"
+	prometheus "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBreakdownSourceCapture(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		s := breakdownSource{query: &testBreakdownQuery{
+			labels: []string{"verb", "resource"},
+			values: []model.Value{model.Vector{
+				sample(10, "verb", "get", "resource", "spaces"),
+				sample(5, "verb", "list", "resource", "spaces"),
+			}},
+		}}
+
+		// when
+		values, err := s.Capture()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, map[string]float64{
+			"verb=get, resource=spaces":  10,
+			"verb=list, resource=spaces": 5,
+		}, values)
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		// given
+		s := breakdownSource{query: &testBreakdownQuery{err: fmt.Errorf("test query error")}}
+
+		// when
+		_, err := s.Capture()
+
+		// then
+		require.EqualError(t, err, "metrics query failed - check whether prometheus is still healthy in the cluster: test query error")
+	})
+}
+
+func TestOperatorRequestsSourceCapture(t *testing.T) {
+	// given
+	start := time.Date(2026, 10, 18, 10, 45, 0, 0, time.UTC)
+	hostRequests := func(count int64) apiserverv1.PerResourceAPIRequestLog {
+		return apiserverv1.PerResourceAPIRequestLog{
+			ByNode: []apiserverv1.PerNodeAPIRequestLog{{
+				NodeName: "master-0",
+				ByUser: []apiserverv1.PerUserAPIRequestCount{
+					{
+						UserName: "system:serviceaccount:toolchain-host-operator:host-operator-controller-manager",
+						ByVerb: []apiserverv1.PerVerbAPIRequestCount{
+							{Verb: "get", RequestCount: count},
+							{Verb: "update", RequestCount: count / 10},
+						},
+					},
+					{
+						UserName: "kube:admin",
+						ByVerb: []apiserverv1.PerVerbAPIRequestCount{
+							{Verb: "get", RequestCount: 100},
+						},
+					},
+				},
+			}},
+		}
+	}
+	last24h := make([]apiserverv1.PerResourceAPIRequestLog, 24)
+	// the hour before the start isn't part of the run
+	last24h[9] = hostRequests(1000)
+	// 100 requests were made in the hour of the start before the start
+	last24h[10] = hostRequests(100)
+	count := &apiserverv1.APIRequestCount{
+		ObjectMeta: metav1.ObjectMeta{Name: "spaces.v1alpha1.toolchain.dev.openshift.com"},
+		Status:     apiserverv1.APIRequestCountStatus{Last24h: last24h},
+	}
+	cl := test.NewFakeClient(t, count)
+	now := start
+	var window time.Duration
+	s := &operatorRequestsSource{
+		k8sClient: cl,
+		operators: map[string]string{
+			"host-operator":   "toolchain-host-operator",
+			"member-operator": "toolchain-member-operator",
+		},
+		latency: func(w time.Duration) queries.BreakdownQuery {
+			window = w
+			return &testBreakdownQuery{
+				labels: []string{"verb", "resource"},
+				values: []model.Value{model.Vector{
+					sample(0.01, "verb", "GET", "resource", "spaces"),
+					sample(0.12, "verb", "PUT", "resource", "spaces"),
+				}},
+			}
+		},
+		now: func() time.Time { return now },
+	}
+	require.NoError(t, s.Start())
+
+	// 200 more requests in the hour of the start and 300 in the next hour
+	count.Status.Last24h[10] = hostRequests(300)
+	count.Status.Last24h[11] = hostRequests(300)
+	require.NoError(t, cl.Update(context.TODO(), count))
+	now = start.Add(40 * time.Minute)
+
+	// when
+	values, err := s.Capture()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 40*time.Minute, window)
+	assert.Equal(t, map[string]float64{
+		"user=host-operator, verb=get, resource=spaces, requests":    500,
+		"user=host-operator, verb=update, resource=spaces, requests": 50,
+		"user=host-operator, requests":                               550,
+		"user=host-operator, rate (req/s)":                           550.0 / 2400,
+		"user=host-operator, mean latency (s)":                       (500*0.01 + 50*0.12) / 550,
+	}, values)
+}
+
+func TestControlPlaneImpactComputeResults(t *testing.T) {
+	// given
+	q := &testBreakdownQuery{
+		name:   "etcd Objects",
+		labels: []string{"resource"},
+		values: []model.Value{
+			model.Vector{sample(3, "resource", "spaces")},
+			model.Vector{sample(2003, "resource", "spaces"), sample(2000, "resource", "usersignups")},
+		},
+	}
+	term := terminal.New(nil, nil, false)
+	impact := newControlPlaneImpact(term, []snapshotSource{breakdownSource{query: q}}, nil)
+
+	// when
+	impact.Capture(BeforeProvisioning)
+	impact.Capture(AfterProvisioning)
+	results := impact.ComputeResults()
+
+	// then
+	assert.Equal(t, [][]string{
+		{"Before etcd Objects [resource=spaces]", "3.0000"},
+		{"After etcd Objects [resource=spaces]", "2003.0000"},
+		{"Delta etcd Objects [resource=spaces]", "2000.0000"},
+		{"Before etcd Objects [resource=usersignups]", "0.0000"},
+		{"After etcd Objects [resource=usersignups]", "2000.0000"},
+		{"Delta etcd Objects [resource=usersignups]", "2000.0000"},
+	}, results)
+}
+
+func sample(value float64, labels ...string) *model.Sample {
+	metric := model.Metric{}
+	for i := 0; i < len(labels); i += 2 {
+		metric[model.LabelName(labels[i])] = model.LabelValue(labels[i+1])
+	}
+	return &model.Sample{
+		Metric: metric,
+		Value:  model.SampleValue(value),
+	}
+}
+
+type testBreakdownQuery struct {
+	name   string
+	labels []string
+	// values are returned in order, one for each execution
+	values []model.Value
+	err    error
+}
+
+func (q *testBreakdownQuery) Name() string {
+	return q.name
+}
+
+func (q *testBreakdownQuery) Labels() []string {
+	return q.labels
+}
+
+func (q *testBreakdownQuery) Execute() (model.Value, prometheus.Warnings, error) {
+	if q.err != nil {
+		return nil, nil, q.err
+	}
+	val := q.values[0]
+	q.values = q.values[1:]
+	return val, nil, nil
+}
+
+func (q *testBreakdownQuery) ResultType() string {
+	return "simple"
+}
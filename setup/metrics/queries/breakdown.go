@@ -0,0 +1,131 @@
+package queries
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	prometheus "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+)
+
+// TierResources are the resources that are created for each provisioned user, either by the host and member operators
+// or by the space tier templates. They are used to scope the control plane impact queries.
+var TierResources = []string{
+	"usersignups",
+	"masteruserrecords",
+	"spaces",
+	"spacebindings",
+	"nstemplatesets",
+	"useraccounts",
+	"namespaces",
+	"rolebindings",
+	"roles",
+	"limitranges",
+	"resourcequotas",
+	"clusterresourcequotas",
+	"networkpolicies",
+	"serviceaccounts",
+	"configmaps",
+	"secrets",
+	"identities",
+	"users",
+}
+
+// BreakdownQuery is a query which returns one value per combination of its labels, eg. per verb and resource.
+// It is not aggregated over time but captured at specific points of the run (eg. before and after provisioning).
+type BreakdownQuery interface {
+	Name() string
+	Labels() []string
+	Execute() (model.Value, prometheus.Warnings, error)
+	ResultType() string
+}
+
+type BaseBreakdownQuery struct {
+	BaseQuery
+	labels []string
+}
+
+func (b *BaseBreakdownQuery) Labels() []string {
+	return b.labels
+}
+
+func tierResourcesRegex() string {
+	return strings.Join(TierResources, "|")
+}
+
+func QueryEtcdObjectCounts(apiClient prometheus.API) *BaseBreakdownQuery {
+	return &BaseBreakdownQuery{
+		BaseQuery: BaseQuery{
+			apiClient:  apiClient,
+			name:       "etcd Objects",
+			query:      fmt.Sprintf(`max(apiserver_storage_objects{resource=~"(%s)(\\..*)?"}) by (resource)`, tierResourcesRegex()),
+			resultType: Simple,
+		},
+		labels: []string{"resource"},
+	}
+}
+
+func QueryAPIRequestRate(apiClient prometheus.API) *BaseBreakdownQuery {
+	return &BaseBreakdownQuery{
+		BaseQuery: BaseQuery{
+			apiClient:  apiClient,
+			name:       "API Request Rate (req/s)",
+			query:      fmt.Sprintf(`sum(rate(apiserver_request_total{resource=~"%s"}[5m])) by (verb, resource)`, tierResourcesRegex()),
+			resultType: Simple,
+		},
+		labels: []string{"verb", "resource"},
+	}
+}
+
+func QueryAPIRequestLatency(apiClient prometheus.API) *BaseBreakdownQuery {
+	return &BaseBreakdownQuery{
+		BaseQuery: BaseQuery{
+			apiClient:  apiClient,
+			name:       "API Request Latency p99 (s)",
+			query:      fmt.Sprintf(`histogram_quantile(0.99, sum(rate(apiserver_request_duration_seconds_bucket{resource=~"%s", verb!~"WATCH|CONNECT"}[5m])) by (le, verb, resource))`, tierResourcesRegex()),
+			resultType: Simple,
+		},
+		labels: []string{"verb", "resource"},
+	}
+}
+
+func QueryAPFRejectedRequests(apiClient prometheus.API) *BaseBreakdownQuery {
+	return &BaseBreakdownQuery{
+		BaseQuery: BaseQuery{
+			apiClient:  apiClient,
+			name:       "APF Rejected Requests",
+			query:      `sum(apiserver_flowcontrol_rejected_requests_total) by (priority_level, reason)`,
+			resultType: Simple,
+		},
+		labels: []string{"priority_level", "reason"},
+	}
+}
+
+func QueryWatchCounts(apiClient prometheus.API) *BaseBreakdownQuery {
+	return &BaseBreakdownQuery{
+		BaseQuery: BaseQuery{
+			apiClient:  apiClient,
+			name:       "Watches",
+			query:      fmt.Sprintf(`sum(apiserver_longrunning_requests{verb="WATCH", resource=~"%s"}) by (resource)`, tierResourcesRegex()),
+			resultType: Simple,
+		},
+		labels: []string{"resource"},
+	}
+}
+
+// QueryAPIRequestMeanLatency returns the mean latency of the API requests made during the given window, by verb and resource.
+// It is not limited to the tier resources so that it covers all the requests made by the operators.
+func QueryAPIRequestMeanLatency(apiClient prometheus.API, window time.Duration) *BaseBreakdownQuery {
+	selector := `verb!~"WATCH|CONNECT"`
+	rng := fmt.Sprintf("%ds", int(window.Seconds()))
+	return &BaseBreakdownQuery{
+		BaseQuery: BaseQuery{
+			apiClient:  apiClient,
+			name:       "API Request Mean Latency (s)",
+			query:      fmt.Sprintf(`sum(rate(apiserver_request_duration_seconds_sum{%[1]s}[%[2]s])) by (verb, resource) / sum(rate(apiserver_request_duration_seconds_count{%[1]s}[%[2]s])) by (verb, resource)`, selector, rng),
+			resultType: Simple,
+		},
+		labels: []string{"verb", "resource"},
+	}
+}
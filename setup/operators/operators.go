@@ -3,6 +3,7 @@ package operators
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -63,15 +64,45 @@ func VerifySandboxOperatorsInstalled(cl client.Client) error {
 	return fmt.Errorf("the sandbox host and/or member operators were not found")
 }
 
-func EnsureOperatorsInstalled(ctx context.Context, cl client.Client, s *runtime.Scheme, templatePaths []string) error {
+// IsSandboxOperator returns true if the given subscription is the one of the sandbox host or member operator
+func IsSandboxOperator(subscriptionName string) bool {
+	return strings.HasPrefix(subscriptionName, hostSubscriptionName) || IsMemberOperator(subscriptionName)
+}
+
+// IsMemberOperator returns true if the given subscription is the one of the sandbox member operator
+func IsMemberOperator(subscriptionName string) bool {
+	return strings.HasPrefix(subscriptionName, memberSubscriptionName)
+}
+
+// SubscriptionNamespaces returns the namespaces of the Subscriptions of the given operator install templates, without duplicates
+func SubscriptionNamespaces(s *runtime.Scheme, templatePaths []string) ([]string, error) {
+	var namespaces []string
 	for _, templatePath := range templatePaths {
-		tmpl, err := templates.GetTemplateFromFile(templatePath)
+		objs, err := processTemplate(s, templatePath)
 		if err != nil {
-			return fmt.Errorf("invalid template file: '%s': %w", templatePath, err)
+			return nil, err
+		}
+		for _, obj := range objs {
+			if obj.GetObjectKind().GroupVersionKind().Kind == "Subscription" && !slices.Contains(namespaces, obj.GetNamespace()) {
+				namespaces = append(namespaces, obj.GetNamespace())
+			}
 		}
+	}
+	return namespaces, nil
+}
 
-		processor := ctemplate.NewProcessor(s)
-		objsToProcess, err := processor.Process(tmpl.DeepCopy(), map[string]string{})
+func processTemplate(s *runtime.Scheme, templatePath string) ([]client.Object, error) {
+	tmpl, err := templates.GetTemplateFromFile(templatePath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template file: '%s': %w", templatePath, err)
+	}
+	processor := ctemplate.NewProcessor(s)
+	return processor.Process(tmpl.DeepCopy(), map[string]string{})
+}
+
+func EnsureOperatorsInstalled(ctx context.Context, cl client.Client, s *runtime.Scheme, templatePaths []string) error {
+	for _, templatePath := range templatePaths {
+		objsToProcess, err := processTemplate(s, templatePath)
 		if err != nil {
 			return err
 		}
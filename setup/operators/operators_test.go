@@ -10,6 +10,7 @@ import (
 	"github.com/codeready-toolchain/toolchain-e2e/setup/test"
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -146,6 +147,29 @@ func TestEnsureOperatorsInstalled(t *testing.T) {
 	})
 }
 
+func TestSubscriptionNamespaces(t *testing.T) {
+	// given
+	scheme, err := configuration.NewScheme()
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		// when
+		namespaces, err := SubscriptionNamespaces(scheme, []string{"installtemplates/kiali.yaml", "installtemplates/pipelines.yaml", "installtemplates/serverless-operator.yaml"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"openshift-operators", "serverless-operator"}, namespaces)
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		// when
+		_, err := SubscriptionNamespaces(scheme, []string{"installtemplates/unknown.yaml"})
+
+		// then
+		require.ErrorContains(t, err, "invalid template file: 'installtemplates/unknown.yaml'")
+	})
+}
+
 func kialiCSV(phase v1alpha1.ClusterServiceVersionPhase) *v1alpha1.ClusterServiceVersion {
 	return &v1alpha1.ClusterServiceVersion{
 		ObjectMeta: metav1.ObjectMeta{
@@ -48,6 +48,11 @@ func (r *Results) AddResults(results [][]string) {
 	r.results = append(r.results, results...)
 }
 
+// Rows returns the results that were added so far
+func (r *Results) Rows() [][]string {
+	return r.results
+}
+
 type csvWriter struct {
 	f *os.File
 }
@@ -5,6 +5,8 @@ import (
 	commontest "github.com/codeready-toolchain/toolchain-common/pkg/test"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	apiserverv1 "github.com/openshift/api/apiserver/v1"
+	configv1 "github.com/openshift/api/config/v1"
 	quotav1 "github.com/openshift/api/quota/v1"
 	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 
@@ -16,7 +18,7 @@ import (
 
 func NewFakeClient(t commontest.T, initObjs ...client.Object) *commontest.FakeClient {
 	s := scheme.Scheme
-	builder := append(runtime.SchemeBuilder{}, toolchainv1alpha1.AddToScheme, quotav1.Install, operatorsv1alpha1.AddToScheme)
+	builder := append(runtime.SchemeBuilder{}, toolchainv1alpha1.AddToScheme, quotav1.Install, operatorsv1alpha1.AddToScheme, apiserverv1.Install, configv1.Install)
 	err := builder.AddToScheme(s)
 	require.NoError(t, err)
 	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(initObjs...).Build()
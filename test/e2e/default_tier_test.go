@@ -11,6 +11,7 @@ import (
 func TestSetDefaultTier(t *testing.T) {
 	// given
 	awaitilities := WaitForDeployments(t)
+	RequirePrerequisites(t, PrerequisiteTiers)
 	hostAwait := awaitilities.Host()
 	memberAwait := awaitilities.Member1()
 
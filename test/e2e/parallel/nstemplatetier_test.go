@@ -37,6 +37,7 @@ func TestNSTemplateTiers(t *testing.T) {
 	t.Parallel()
 	// given
 	awaitilities := WaitForDeployments(t)
+	RequirePrerequisites(t, PrerequisiteTiers)
 	hostAwait := awaitilities.Host()
 
 	user := NewSignupRequest(awaitilities).
@@ -160,6 +160,7 @@ func TestProxyFlow(t *testing.T) {
 	t.Parallel()
 	// given
 	awaitilities := WaitForDeployments(t)
+	RequirePrerequisites(t, PrerequisiteAPIProxy)
 	hostAwait := awaitilities.Host()
 	memberAwait := awaitilities.Member1()
 	memberAwait2 := awaitilities.Member2()
@@ -783,6 +784,7 @@ func TestSpaceLister(t *testing.T) {
 	t.Parallel()
 	// given
 	awaitilities := WaitForDeployments(t)
+	RequirePrerequisites(t, PrerequisiteAPIProxy)
 	hostAwait := awaitilities.Host()
 	memberAwait := awaitilities.Member1()
 	memberAwait2 := awaitilities.Member2()
@@ -53,6 +53,7 @@ func TestLandingPageReachable(t *testing.T) {
 func TestRegistrationServiceMetricsEndpoint(t *testing.T) {
 	// given
 	await := WaitForDeployments(t)
+	RequirePrerequisites(t, PrerequisiteMetricsRoutes)
 	t.Parallel()
 
 	t.Run("not available from default route", func(t *testing.T) { // make sure that the `/metrics`` endpoint is NOT reachable with the default route
@@ -524,6 +525,7 @@ func TestPhoneVerification(t *testing.T) {
 	// given
 	t.Parallel()
 	await := WaitForDeployments(t)
+	Requires(t, TagPhoneVerification)
 	route := await.Host().RegistrationServiceURL
 
 	hostAwait := await.Host()
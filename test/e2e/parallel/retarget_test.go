@@ -16,6 +16,7 @@ func TestRetargetUserByChangingSpaceTargetClusterWhenSpaceIsNotShared(t *testing
 	// given
 	t.Parallel()
 	awaitilities := WaitForDeployments(t)
+	Requires(t, TagSecondMember)
 	hostAwait := awaitilities.Host()
 	member1Await := awaitilities.Member1()
 	member2Await := awaitilities.Member2()
@@ -50,6 +51,7 @@ func TestRetargetUserByChangingSpaceTargetClusterWhenSpaceIsShared(t *testing.T)
 	// given
 	t.Parallel()
 	awaitilities := WaitForDeployments(t)
+	Requires(t, TagSecondMember)
 	hostAwait := awaitilities.Host()
 	member1Await := awaitilities.Member1()
 	member2Await := awaitilities.Member2()
@@ -125,6 +127,7 @@ func TestRetargetUserWithSBRByChangingSpaceTargetClusterWhenSpaceIsShared(t *tes
 	// given
 	t.Parallel()
 	awaitilities := WaitForDeployments(t)
+	Requires(t, TagSecondMember)
 	hostAwait := awaitilities.Host()
 	member1Await := awaitilities.Member1()
 	member2Await := awaitilities.Member2()
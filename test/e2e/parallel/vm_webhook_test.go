@@ -26,6 +26,7 @@ func TestCreateVirtualMachine(t *testing.T) {
 
 	// make sure everything is ready before running the actual tests
 	awaitilities := WaitForDeployments(t)
+	Requires(t, TagWebhook)
 	memberAwait := awaitilities.Member1()
 
 	client, err := dynamic.NewForConfig(memberAwait.RestConfig)
@@ -57,6 +57,7 @@ func (u *proxyUser) Email() string {
 func TestProxyPublicViewer(t *testing.T) {
 	// make sure everything is ready before running the actual tests
 	awaitilities := WaitForDeployments(t)
+	RequirePrerequisites(t, PrerequisiteAPIProxy)
 	hostAwait := awaitilities.Host()
 	memberAwait := awaitilities.Member1()
 
@@ -22,6 +22,8 @@ import (
 func TestAutomaticClusterAssignment(t *testing.T) {
 	// given
 	awaitilities := WaitForDeployments(t)
+	Requires(t, TagSecondMember, TagAppStudioTier)
+	RequirePrerequisites(t, PrerequisiteMetricsRoutes)
 	hostAwait := awaitilities.Host()
 	memberAwait1 := awaitilities.Member1()
 	memberAwait2 := awaitilities.Member2()
@@ -12,6 +12,7 @@ import (
 func TestToolchainStatusUnready(t *testing.T) {
 	// given
 	awaitilities := WaitForDeployments(t)
+	RequirePrerequisites(t, PrerequisiteToolchainStatus)
 	hostAwait := awaitilities.Host()
 	memberAwait := awaitilities.Member1()
 
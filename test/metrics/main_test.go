@@ -0,0 +1,12 @@
+package e2e
+
+import (
+	"os"
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(testsupport.RunSuite(m, "metrics"))
+}
@@ -30,6 +30,7 @@ import (
 func TestOperatorVersionMetrics(t *testing.T) {
 	// given
 	awaitilities := WaitForDeployments(t)
+	RequirePrerequisites(t, PrerequisiteMetricsRoutes)
 
 	t.Run("host-operator", func(t *testing.T) {
 
@@ -133,6 +134,7 @@ func TestOperatorVersionMetrics(t *testing.T) {
 func TestMetricsWhenUsersManuallyApprovedAndThenDeactivated(t *testing.T) {
 	// given
 	awaitilities := WaitForDeployments(t)
+	RequirePrerequisites(t, PrerequisiteMetricsRoutes)
 	hostAwait := awaitilities.Host()
 	memberAwait := awaitilities.Member1()
 	memberAwait2 := awaitilities.Member2()
@@ -220,6 +222,7 @@ func TestMetricsWhenUsersManuallyApprovedAndThenDeactivated(t *testing.T) {
 func TestMetricsWhenUsersAutomaticallyApprovedAndThenDeactivated(t *testing.T) {
 	// given
 	awaitilities := WaitForDeployments(t)
+	RequirePrerequisites(t, PrerequisiteMetricsRoutes)
 	hostAwait := awaitilities.Host()
 	memberAwait := awaitilities.Member1()
 	memberAwait2 := awaitilities.Member2()
@@ -285,6 +288,7 @@ func TestMetricsWhenUsersAutomaticallyApprovedAndThenDeactivated(t *testing.T) {
 func TestVerificationRequiredMetric(t *testing.T) {
 	// given
 	awaitilities := WaitForDeployments(t)
+	RequirePrerequisites(t, PrerequisiteMetricsRoutes)
 	hostAwait := awaitilities.Host()
 	memberAwait := awaitilities.Member1()
 	memberAwait2 := awaitilities.Member2()
@@ -403,6 +407,7 @@ func TestVerificationRequiredMetric(t *testing.T) {
 func TestMetricsWhenUsersDeactivatedAndReactivated(t *testing.T) {
 	// given
 	awaitilities := WaitForDeployments(t)
+	RequirePrerequisites(t, PrerequisiteMetricsRoutes)
 	hostAwait := awaitilities.Host()
 	memberAwait := awaitilities.Member1()
 	hostAwait.UpdateToolchainConfig(t, testconfig.AutomaticApproval().Enabled(false))
@@ -487,6 +492,7 @@ func TestMetricsWhenUsersDeactivatedAndReactivated(t *testing.T) {
 func TestMetricsWhenUsersDeleted(t *testing.T) {
 	// given
 	awaitilities := WaitForDeployments(t)
+	RequirePrerequisites(t, PrerequisiteMetricsRoutes)
 	hostAwait := awaitilities.Host()
 	memberAwait := awaitilities.Member1()
 	hostAwait.UpdateToolchainConfig(t, testconfig.AutomaticApproval().Enabled(false))
@@ -543,6 +549,7 @@ func TestMetricsWhenUsersDeleted(t *testing.T) {
 func TestMetricsWhenUsersBanned(t *testing.T) {
 	// given
 	awaitilities := WaitForDeployments(t)
+	RequirePrerequisites(t, PrerequisiteMetricsRoutes)
 	hostAwait := awaitilities.Host()
 	memberAwait := awaitilities.Member1()
 	memberAwait2 := awaitilities.Member2()
@@ -619,6 +626,7 @@ func TestMetricsWhenUsersBanned(t *testing.T) {
 func TestMetricsWhenUserDisabled(t *testing.T) {
 	// given
 	awaitilities := WaitForDeployments(t)
+	RequirePrerequisites(t, PrerequisiteMetricsRoutes)
 	hostAwait := awaitilities.Host()
 	memberAwait := awaitilities.Member1()
 	memberAwait2 := awaitilities.Member2()
@@ -720,6 +728,7 @@ func banUser(t *testing.T, hostAwait *wait.HostAwaitility, email string) *toolch
 func TestForceMetricsSynchronization(t *testing.T) {
 	// given
 	awaitilities := WaitForDeployments(t)
+	RequirePrerequisites(t, PrerequisiteMetricsRoutes)
 	hostAwait := awaitilities.Host()
 	hostAwait.UpdateToolchainConfig(t, testconfig.AutomaticApproval().Enabled(true))
 
@@ -0,0 +1,12 @@
+package setup
+
+import (
+	"os"
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(testsupport.RunMigrationSuite(m, "migration-setup"))
+}
@@ -264,14 +264,17 @@ func verifyAdditionalDeploymentsCreatedUsingSSA(t *testing.T, awaitilities *wait
 
 	// no webhook is deployed in member2, see the "create-host-resources" make target
 	t.Run("verify webhook deployed using SSA in member1", func(t *testing.T) {
+		RequirePrerequisites(t, PrerequisiteWebhook)
 		testDeployment(t, awaitilities.Member1().Awaitility, "member-operator-webhook", "member-operator", "kubesaw-member-operator")
 	})
 
 	t.Run("verify autoscaler deployed using SSA in member1", func(t *testing.T) {
+		RequirePrerequisites(t, PrerequisiteAutoscalingBuffer)
 		testDeployment(t, awaitilities.Member1().Awaitility, "autoscaling-buffer", "member-operator", "kubesaw-member-operator")
 	})
 
 	t.Run("verify autoscaler deployed using SSA in member2", func(t *testing.T) {
+		RequirePrerequisites(t, PrerequisiteAutoscalingBuffer)
 		testDeployment(t, awaitilities.Member2().Awaitility, "autoscaling-buffer", "member-operator", "kubesaw-member-operator")
 	})
 }
@@ -0,0 +1,32 @@
+package sweep
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	. "github.com/codeready-toolchain/toolchain-e2e/testsupport"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+	"github.com/stretchr/testify/require"
+)
+
+// sweepOlderThanVar is the name of the env var with the minimum age (eg, `30m`) of the objects to sweep, so that the objects
+// of the runs which may still be in progress are not deleted
+const sweepOlderThanVar = "SWEEP_OLDER_THAN"
+
+// defaultSweepOlderThan is the minimum age of the objects to sweep when the SWEEP_OLDER_THAN env var is not set
+const defaultSweepOlderThan = 2 * time.Hour
+
+func TestSweepStaleRuns(t *testing.T) {
+	// given
+	awaitilities := WaitForOperators(t)
+	olderThan := defaultSweepOlderThan
+	if value := os.Getenv(sweepOlderThanVar); value != "" {
+		var err error
+		olderThan, err = time.ParseDuration(value)
+		require.NoError(t, err, "invalid value of the %s env var", sweepOlderThanVar)
+	}
+
+	// when/then
+	wait.SweepStaleRuns(t, awaitilities, olderThan)
+}
@@ -33,11 +33,27 @@ var (
 
 type cleanManager struct {
 	sync.RWMutex
-	cleanTasks map[*testing.T][]*cleanTask
+	cleanTasks map[testing.TB][]*cleanTask
+	// registrations contains all the objects which were registered for cleanup since the start of the suite
+	registrations []Registration
 }
 
 var cleaning = &cleanManager{
-	cleanTasks: map[*testing.T][]*cleanTask{},
+	cleanTasks: map[testing.TB][]*cleanTask{},
+}
+
+// Registration is an object which was registered for cleanup by a test
+type Registration struct {
+	Object client.Object
+	Test   string
+}
+
+// Registrations returns all the objects which were registered for cleanup since the start of the suite, even if they were
+// cleaned since then
+func Registrations() []Registration {
+	cleaning.RLock()
+	defer cleaning.RUnlock()
+	return append([]Registration{}, cleaning.registrations...)
 }
 
 type AwaitilityInt interface {
@@ -45,15 +61,15 @@ type AwaitilityInt interface {
 }
 
 // AddCleanTasks adds cleaning tasks for the given objects that will be automatically performed at the end of the test execution
-func AddCleanTasks(t *testing.T, cl client.Client, objects ...client.Object) {
+func AddCleanTasks(t testing.TB, cl client.Client, objects ...client.Object) {
 	AddCleanTasksWithTimeout(t, cl, defaultTimeout, objects...)
 }
 
-func AddCleanTasksWithTimeout(t *testing.T, cl client.Client, timeout time.Duration, objects ...client.Object) {
+func AddCleanTasksWithTimeout(t testing.TB, cl client.Client, timeout time.Duration, objects ...client.Object) {
 	cleaning.addCleanTasks(t, cl, timeout, objects...)
 }
 
-func (c *cleanManager) addCleanTasks(t *testing.T, cl client.Client, timeout time.Duration, objects ...client.Object) {
+func (c *cleanManager) addCleanTasks(t testing.TB, cl client.Client, timeout time.Duration, objects ...client.Object) {
 	c.Lock()
 	defer c.Unlock()
 	for _, obj := range objects {
@@ -61,52 +77,89 @@ func (c *cleanManager) addCleanTasks(t *testing.T, cl client.Client, timeout tim
 			t.Cleanup(c.clean(t))
 		}
 		c.cleanTasks[t] = append(c.cleanTasks[t], newCleanTask(t, cl, obj, timeout))
+		c.registrations = append(c.registrations, Registration{Object: obj, Test: t.Name()})
 	}
 }
 
 // ExecuteAllCleanTasks triggers cleanup of all resources that were marked to be cleaned before that
-func ExecuteAllCleanTasks(t *testing.T) {
+func ExecuteAllCleanTasks(t testing.TB) {
 	cleaning.clean(t)()
 }
 
-func (c *cleanManager) clean(t *testing.T) func() {
+func (c *cleanManager) clean(t testing.TB) func() {
 	return func() {
 		c.Lock()
 		defer c.Unlock()
-		if !t.Failed() {
-			var wg sync.WaitGroup
-			for _, task := range c.cleanTasks[t] {
-				wg.Add(1)
-				go func(cleanTask *cleanTask) {
-					defer wg.Done()
-					cleanTask.clean()
-				}(task)
-			}
-			wg.Wait()
-		} else {
+		tasks := c.cleanTasks[t]
+		c.cleanTasks[t] = nil
+		policy := cleanupPolicy()
+		switch {
+		case policy == NeverCleanupPolicy:
+			retaining.retain(t, tasks, "the cleanup policy is 'never'", false)
+		case policy == AlwaysCleanupPolicy || !t.Failed():
+			executeCleanTasks(tasks)
+		case policy == KeepOnFailureThenSweepCleanupPolicy:
+			retaining.retain(t, tasks, "the test failed, the objects will be deleted at the end of the suite", true)
+		default:
 			t.Logf(
 				"skipping object cleanup, test=%s failedTimestamp=%s",
 				t.Name(),
 				time.Now().Format(time.StampMilli),
 			)
+			retaining.retain(t, tasks, "the test failed", false)
 		}
-		c.cleanTasks[t] = nil
 	}
 }
 
+// executeCleanTasks executes the tasks in parallel, except that each task waits until the tasks of the objects which must be
+// deleted before its own object are done (see DeleteBefore and DeleteKindBefore)
+func executeCleanTasks(tasks []*cleanTask) {
+	before := prerequisites(tasks)
+	done := make(map[*cleanTask]chan struct{}, len(tasks))
+	for _, task := range tasks {
+		done[task] = make(chan struct{})
+	}
+	var wg sync.WaitGroup
+	for _, task := range tasks {
+		wg.Add(1)
+		go func(cleanTask *cleanTask) {
+			defer wg.Done()
+			// also closed when the task fails, so that the next tasks are not blocked
+			defer close(done[cleanTask])
+			for _, first := range before[cleanTask] {
+				<-done[first]
+			}
+			cleanTask.clean()
+		}(task)
+	}
+	wg.Wait()
+}
+
+// testingT is the subset of testing.T used by the clean tasks, so that they can also be executed once the test is over
+// (see SweepRetained)
+type testingT interface {
+	require.TestingT
+	Logf(format string, args ...any)
+	Log(args ...any)
+}
+
 type cleanTask struct {
 	sync.Once
 	objToClean client.Object
 	client     client.Client
-	t          *testing.T
+	t          testingT
 	timeout    time.Duration
+	// after contains the tasks of the objects which must be deleted before this one (see DeleteBefore)
+	after []*cleanTask
+	// verifiers are called once the object is deleted (see VerifyDeleted)
+	verifiers []func(ctx context.Context) (bool, error)
 }
 
 func (c *cleanTask) clean() {
 	c.Do(c.cleanObject)
 }
 
-func newCleanTask(t *testing.T, cl client.Client, obj client.Object, timeout time.Duration) *cleanTask {
+func newCleanTask(t testingT, cl client.Client, obj client.Object, timeout time.Duration) *cleanTask {
 	return &cleanTask{
 		t:          t,
 		client:     cl,
@@ -123,9 +176,10 @@ func (c *cleanTask) cleanObject() {
 	require.True(c.t, ok)
 	userSignup, isUserSignup := c.objToClean.(*toolchainv1alpha1.UserSignup)
 	nsTemplateTier, isNsTemplateTier := c.objToClean.(*toolchainv1alpha1.NSTemplateTier)
-	kind := objToClean.GetObjectKind().GroupVersionKind().Kind
-	if kind == "" {
-		kind = reflect.TypeOf(c.objToClean).Elem().Name()
+	kind := KindOf(c.objToClean)
+	// get the latest version of the object, so that its status can be used to verify the deletion of the related objects
+	if err := c.client.Get(context.TODO(), client.ObjectKeyFromObject(objToClean), objToClean); err != nil && !errors.IsNotFound(err) {
+		c.t.Logf("problem with getting the %s '%s': %s", kind, objToClean.GetName(), err)
 	}
 	c.t.Logf("deleting %s: %s ...", kind, objToClean.GetName())
 	if err := c.client.Delete(context.TODO(), objToClean, propagationPolicyOpts); err != nil {
@@ -164,7 +218,8 @@ func (c *cleanTask) cleanObject() {
 				if ttrsDeleted, err := c.verifyTierTemplateRevisionsDeleted(isNsTemplateTier, nsTemplateTier, false); !ttrsDeleted || err != nil {
 					return false, err
 				}
-				return true, nil
+				// let's also check that the objects deleted along with it are deleted
+				return c.verifyDeleted(ctx, objToClean)
 			}
 			c.t.Logf("problem with getting the related %s '%s': %s", kind, objToClean.GetName(), err)
 			return false, err
@@ -203,6 +258,14 @@ func (c *cleanTask) cleanObject() {
 	}
 }
 
+// KindOf returns the kind of the object, from its GroupVersionKind if it's set, or else from its type
+func KindOf(obj client.Object) string {
+	if kind := obj.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+		return kind
+	}
+	return reflect.TypeOf(obj).Elem().Name()
+}
+
 func (c *cleanTask) checkIfStillPresent(obj client.Object, kind, namespace, name string) string {
 	err := c.client.Get(context.TODO(), test.NamespacedName(namespace, name), obj)
 	if err == nil {
@@ -0,0 +1,206 @@
+package cleanup
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DeletionVerifier returns true once the objects which are deleted along with the given object (eg, by the operators) are deleted.
+// It is called with the last known version of the object, once the object itself is deleted.
+type DeletionVerifier func(ctx context.Context, cl client.Client, obj client.Object) (bool, error)
+
+var graph = struct {
+	sync.RWMutex
+	// kindOrders contains the kinds whose objects must be deleted after the ones of the kind in the key
+	kindOrders map[string][]string
+	verifiers  map[string][]DeletionVerifier
+}{
+	kindOrders: map[string][]string{},
+	verifiers:  map[string][]DeletionVerifier{},
+}
+
+func init() {
+	// the requests are deleted before the Spaces they were created in, so that the operators don't race with the finalizers
+	DeleteKindBefore("SpaceBindingRequest", "Space")
+	DeleteKindBefore("SpaceBindingRequest", "UserSignup")
+	DeleteKindBefore("SpaceRequest", "Space")
+	DeleteKindBefore("SpaceRequest", "UserSignup")
+	DeleteKindBefore("SpaceBinding", "Space")
+	DeleteKindBefore("ProxyPlugin", "Route")
+	RegisterDeletionVerifier("SpaceRequest", verifySpaceRequestNamespacesDeleted)
+}
+
+// DeleteKindBefore declares that the objects of the `first` kind registered by a test must be completely deleted before the objects
+// of the `then` kind registered by the same test are deleted
+func DeleteKindBefore(first, then string) {
+	graph.Lock()
+	defer graph.Unlock()
+	graph.kindOrders[first] = append(graph.kindOrders[first], then)
+}
+
+// RegisterDeletionVerifier registers a verifier which is called for the objects of the given kind, to wait for the deletion of the
+// objects which are deleted along with them
+func RegisterDeletionVerifier(kind string, verifier DeletionVerifier) {
+	graph.Lock()
+	defer graph.Unlock()
+	graph.verifiers[kind] = append(graph.verifiers[kind], verifier)
+}
+
+// DeleteBefore declares that the `first` object must be completely deleted before the `then` object is deleted.
+// Both objects must have been registered for cleanup by the test.
+func DeleteBefore(t testing.TB, first, then client.Object) {
+	cleaning.Lock()
+	defer cleaning.Unlock()
+	firstTask, thenTask := cleaning.findTask(t, first), cleaning.findTask(t, then)
+	if firstTask == nil || thenTask == nil {
+		t.Logf("unable to order the deletion of %s '%s' before %s '%s': both objects must be registered for cleanup by the test",
+			KindOf(first), first.GetName(), KindOf(then), then.GetName())
+		return
+	}
+	thenTask.after = append(thenTask.after, firstTask)
+}
+
+// VerifyDeleted adds a verifier which is called once the given object, registered for cleanup by the test, is deleted, to wait for
+// the deletion of the objects which are deleted along with it
+func VerifyDeleted(t testing.TB, obj client.Object, verify func(ctx context.Context) (bool, error)) {
+	cleaning.Lock()
+	defer cleaning.Unlock()
+	task := cleaning.findTask(t, obj)
+	if task == nil {
+		t.Logf("unable to verify the deletion of %s '%s': the object must be registered for cleanup by the test", KindOf(obj), obj.GetName())
+		return
+	}
+	task.verifiers = append(task.verifiers, verify)
+}
+
+func (c *cleanManager) findTask(t testing.TB, obj client.Object) *cleanTask {
+	for _, task := range c.cleanTasks[t] {
+		if task.objToClean == obj || sameObject(task.objToClean, obj) {
+			return task
+		}
+	}
+	return nil
+}
+
+func sameObject(a, b client.Object) bool {
+	return a != nil && b != nil && KindOf(a) == KindOf(b) && a.GetNamespace() == b.GetNamespace() && a.GetName() == b.GetName()
+}
+
+// prerequisites returns the tasks which must be executed before each task, according to the declared dependencies between the
+// objects and between their kinds, and to the parent Spaces of the subspaces. If the dependencies have a cycle, then no ordering is returned.
+func prerequisites(tasks []*cleanTask) map[*cleanTask][]*cleanTask {
+	graph.RLock()
+	defer graph.RUnlock()
+	included := map[*cleanTask]bool{}
+	for _, task := range tasks {
+		included[task] = true
+	}
+	result := map[*cleanTask][]*cleanTask{}
+	for _, task := range tasks {
+		for _, first := range task.after {
+			if included[first] {
+				result[task] = append(result[task], first)
+			}
+		}
+		if task.objToClean == nil {
+			continue
+		}
+		for _, first := range tasks {
+			if first == task || first.objToClean == nil {
+				continue
+			}
+			if mustDeleteBefore(first.objToClean, task.objToClean) {
+				result[task] = append(result[task], first)
+			}
+		}
+	}
+	if hasCycle(tasks, result) {
+		if len(tasks) > 0 {
+			tasks[0].t.Logf("the dependencies between the objects to clean have a cycle, deleting them in parallel")
+		}
+		return nil
+	}
+	return result
+}
+
+func mustDeleteBefore(first, then client.Object) bool {
+	for _, kind := range graph.kindOrders[KindOf(first)] {
+		if kind == KindOf(then) {
+			return true
+		}
+	}
+	// the subspaces are deleted before their parent Space
+	subSpace, isSpace := first.(*toolchainv1alpha1.Space)
+	parentSpace, isParentSpace := then.(*toolchainv1alpha1.Space)
+	return isSpace && isParentSpace && subSpace.Spec.ParentSpace != "" &&
+		subSpace.Spec.ParentSpace == parentSpace.Name && subSpace.Namespace == parentSpace.Namespace
+}
+
+func hasCycle(tasks []*cleanTask, prerequisites map[*cleanTask][]*cleanTask) bool {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := map[*cleanTask]int{}
+	var visit func(task *cleanTask) bool
+	visit = func(task *cleanTask) bool {
+		switch state[task] {
+		case visiting:
+			return true
+		case visited:
+			return false
+		}
+		state[task] = visiting
+		for _, p := range prerequisites[task] {
+			if visit(p) {
+				return true
+			}
+		}
+		state[task] = visited
+		return false
+	}
+	for _, task := range tasks {
+		if visit(task) {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyDeleted calls the verifiers of the kind of the object and of the task
+func (c *cleanTask) verifyDeleted(ctx context.Context, obj client.Object) (bool, error) {
+	graph.RLock()
+	verifiers := graph.verifiers[KindOf(obj)]
+	graph.RUnlock()
+	for _, verify := range verifiers {
+		if deleted, err := verify(ctx, c.client, obj); !deleted || err != nil {
+			return false, err
+		}
+	}
+	for _, verify := range c.verifiers {
+		if deleted, err := verify(ctx); !deleted || err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// verifySpaceRequestNamespacesDeleted checks that the namespaces provisioned for the SpaceRequest are deleted
+func verifySpaceRequestNamespacesDeleted(ctx context.Context, cl client.Client, obj client.Object) (bool, error) {
+	spaceRequest, ok := obj.(*toolchainv1alpha1.SpaceRequest)
+	if !ok {
+		return true, nil
+	}
+	for _, access := range spaceRequest.Status.NamespaceAccess {
+		if err := cl.Get(ctx, client.ObjectKey{Name: access.Name}, &corev1.Namespace{}); err == nil || !errors.IsNotFound(err) {
+			return false, client.IgnoreNotFound(err)
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,147 @@
+package cleanup
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestPrerequisites(t *testing.T) {
+	newSpace := func(name, parent string) *toolchainv1alpha1.Space {
+		return &toolchainv1alpha1.Space{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "host"},
+			Spec:       toolchainv1alpha1.SpaceSpec{ParentSpace: parent},
+		}
+	}
+
+	t.Run("kinds and subspaces", func(t *testing.T) {
+		// given
+		parent := newCleanTask(t, nil, newSpace("parent", ""), time.Second)
+		subSpace := newCleanTask(t, nil, newSpace("sub", "parent"), time.Second)
+		other := newCleanTask(t, nil, newSpace("other", ""), time.Second)
+		sbr := newCleanTask(t, nil, &toolchainv1alpha1.SpaceBindingRequest{ObjectMeta: metav1.ObjectMeta{Name: "sbr", Namespace: "parent-dev"}}, time.Second)
+		cm := newCleanTask(t, nil, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "parent-dev"}}, time.Second)
+
+		// when
+		result := prerequisites([]*cleanTask{parent, subSpace, other, sbr, cm})
+
+		// then
+		assert.ElementsMatch(t, []*cleanTask{subSpace, sbr}, result[parent])
+		assert.ElementsMatch(t, []*cleanTask{sbr}, result[subSpace])
+		assert.ElementsMatch(t, []*cleanTask{sbr}, result[other])
+		assert.Empty(t, result[sbr])
+		assert.Empty(t, result[cm])
+	})
+
+	t.Run("declared dependencies", func(t *testing.T) {
+		// given
+		cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		first := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "first", Namespace: "default"}}
+		then := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "then", Namespace: "default"}}
+		AddCleanTasks(t, cl, first, then)
+		tasks := cleaning.cleanTasks[t]
+
+		// when
+		DeleteBefore(t, first, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "then", Namespace: "default"}})
+
+		// then
+		result := prerequisites(tasks)
+		assert.Equal(t, []*cleanTask{tasks[0]}, result[tasks[1]])
+		assert.Empty(t, result[tasks[0]])
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		// given
+		first := newCleanTask(t, nil, newSpace("first", ""), time.Second)
+		then := newCleanTask(t, nil, newSpace("then", ""), time.Second)
+		first.after = []*cleanTask{then}
+		then.after = []*cleanTask{first}
+
+		// when
+		result := prerequisites([]*cleanTask{first, then})
+
+		// then
+		assert.Empty(t, result)
+	})
+}
+
+func TestExecuteCleanTasksInOrder(t *testing.T) {
+	// given
+	s := runtime.NewScheme()
+	require.NoError(t, scheme.AddToScheme(s))
+	require.NoError(t, toolchainv1alpha1.AddToScheme(s))
+	var lock sync.Mutex
+	var deleted []string
+	cl := fake.NewClientBuilder().WithScheme(s).
+		WithObjects(
+			&toolchainv1alpha1.Space{ObjectMeta: metav1.ObjectMeta{Name: "parent", Namespace: "host"}},
+			&toolchainv1alpha1.Space{ObjectMeta: metav1.ObjectMeta{Name: "sub", Namespace: "host"}, Spec: toolchainv1alpha1.SpaceSpec{ParentSpace: "parent"}},
+			&toolchainv1alpha1.SpaceBinding{ObjectMeta: metav1.ObjectMeta{Name: "binding", Namespace: "host"}},
+		).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Delete: func(ctx context.Context, cl client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				lock.Lock()
+				deleted = append(deleted, obj.GetName())
+				lock.Unlock()
+				return cl.Delete(ctx, obj, opts...)
+			},
+		}).
+		Build()
+	tasks := []*cleanTask{
+		newCleanTask(t, cl, &toolchainv1alpha1.Space{ObjectMeta: metav1.ObjectMeta{Name: "parent", Namespace: "host"}}, time.Second),
+		newCleanTask(t, cl, &toolchainv1alpha1.Space{ObjectMeta: metav1.ObjectMeta{Name: "sub", Namespace: "host"}, Spec: toolchainv1alpha1.SpaceSpec{ParentSpace: "parent"}}, time.Second),
+		newCleanTask(t, cl, &toolchainv1alpha1.SpaceBinding{ObjectMeta: metav1.ObjectMeta{Name: "binding", Namespace: "host"}}, time.Second),
+	}
+
+	// when
+	executeCleanTasks(tasks)
+
+	// then
+	assert.Equal(t, []string{"binding", "sub", "parent"}, deleted)
+}
+
+func TestVerifySpaceRequestNamespacesDeleted(t *testing.T) {
+	// given
+	spaceRequest := &toolchainv1alpha1.SpaceRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "request", Namespace: "parent-dev"},
+		Status: toolchainv1alpha1.SpaceRequestStatus{
+			NamespaceAccess: []toolchainv1alpha1.NamespaceAccess{{Name: "sub-dev"}},
+		},
+	}
+
+	t.Run("namespace still exists", func(t *testing.T) {
+		// given
+		cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "sub-dev"}}).Build()
+
+		// when
+		deleted, err := verifySpaceRequestNamespacesDeleted(context.TODO(), cl, spaceRequest)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, deleted)
+	})
+
+	t.Run("namespace deleted", func(t *testing.T) {
+		// given
+		cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+
+		// when
+		deleted, err := verifySpaceRequestNamespacesDeleted(context.TODO(), cl, spaceRequest)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, deleted)
+	})
+}
@@ -0,0 +1,225 @@
+package cleanup
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const (
+	// CleanupPolicyVar is the name of the env var which contains the policy applied to the objects of the tests when they are over
+	CleanupPolicyVar = "CLEANUP_POLICY"
+	// CleanupRetentionVar is the name of the env var which contains the duration (eg, `10m`) during which the objects of a failed test
+	// are retained with the keep-on-failure-then-sweep policy, unless the diagnostics of the test were collected before
+	CleanupRetentionVar = "CLEANUP_RETENTION"
+)
+
+// the cleanup policies
+const (
+	// AlwaysCleanupPolicy deletes the objects at the end of the test, even if it failed
+	AlwaysCleanupPolicy = "always"
+	// NeverCleanupPolicy never deletes the objects
+	NeverCleanupPolicy = "never"
+	// KeepOnFailureCleanupPolicy deletes the objects at the end of the test, unless it failed (default)
+	KeepOnFailureCleanupPolicy = "keep-on-failure"
+	// KeepOnFailureThenSweepCleanupPolicy deletes the objects at the end of the test, unless it failed: in this case, the objects
+	// are retained until the end of the suite (see SweepRetained)
+	KeepOnFailureThenSweepCleanupPolicy = "keep-on-failure-then-sweep"
+)
+
+func cleanupPolicy() string {
+	switch policy := os.Getenv(CleanupPolicyVar); policy {
+	case AlwaysCleanupPolicy, NeverCleanupPolicy, KeepOnFailureThenSweepCleanupPolicy:
+		return policy
+	default:
+		return KeepOnFailureCleanupPolicy
+	}
+}
+
+func cleanupRetention() (time.Duration, error) {
+	value := os.Getenv(CleanupRetentionVar)
+	if value == "" {
+		return 0, nil
+	}
+	retention, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value of the %s env var: %w", CleanupRetentionVar, err)
+	}
+	return retention, nil
+}
+
+// retainedTest contains the clean tasks of a test which were not executed when the test was over
+type retainedTest struct {
+	name       string
+	reason     string
+	retainedAt time.Time
+	// swept is true if the objects must be deleted at the end of the suite
+	swept bool
+	// released is true if the diagnostics of the test were collected, so that its objects don't need to be retained anymore
+	released bool
+	tasks    []*cleanTask
+}
+
+var retaining = &retainedTests{}
+
+type retainedTests struct {
+	sync.Mutex
+	tests []*retainedTest
+}
+
+// retain records the tasks of the test which are not executed, and logs the manifest of the objects which are retained
+func (r *retainedTests) retain(t testing.TB, tasks []*cleanTask, reason string, swept bool) {
+	if len(tasks) == 0 {
+		return
+	}
+	t.Logf("retained objects of the test '%s' (%s):\n%s", t.Name(), reason, manifest(tasks))
+	r.Lock()
+	defer r.Unlock()
+	r.tests = append(r.tests, &retainedTest{
+		name:       t.Name(),
+		reason:     reason,
+		retainedAt: time.Now(),
+		swept:      swept,
+		tasks:      tasks,
+	})
+}
+
+// ReleaseRetained notifies that the diagnostics of the test with the given name (and of its subtests) were collected,
+// so that their objects can be deleted at the end of the suite without waiting for the retention duration
+func ReleaseRetained(testName string) {
+	retaining.Lock()
+	defer retaining.Unlock()
+	for _, test := range retaining.tests {
+		if test.name == testName || strings.HasPrefix(test.name, testName+"/") {
+			test.released = true
+		}
+	}
+}
+
+// RetainedTests returns the names of the tests whose objects are retained by the cleanup policy, ie, which are not deleted
+// by the end of the suite (see SweepRetained)
+func RetainedTests() []string {
+	retaining.Lock()
+	defer retaining.Unlock()
+	names := make([]string, 0, len(retaining.tests))
+	for _, test := range retaining.tests {
+		if !test.swept {
+			names = append(names, test.name)
+		}
+	}
+	return names
+}
+
+// SweepRetained deletes the objects of the tests which failed with the keep-on-failure-then-sweep policy, once they have been
+// retained for the duration in the CLEANUP_RETENTION env var or once the diagnostics of the tests were collected.
+// It is meant to be called at the end of the suite (see testsupport.RunSuite), and prints the manifest of the objects which
+// are still retained.
+func SweepRetained() error {
+	retention, err := cleanupRetention()
+	if err != nil {
+		return err
+	}
+	retaining.Lock()
+	tests := retaining.tests
+	retaining.tests = nil
+	retaining.Unlock()
+
+	var toSweep, kept []*retainedTest
+	for _, test := range tests {
+		if !test.swept {
+			fmt.Printf("objects retained by the test '%s' (%s):\n%s\n", test.name, test.reason, manifest(test.tasks))
+			kept = append(kept, test)
+			continue
+		}
+		toSweep = append(toSweep, test)
+	}
+	// the objects which are not swept are still retained once the suite is over (see RetainedTests)
+	retaining.Lock()
+	retaining.tests = append(retaining.tests, kept...)
+	retaining.Unlock()
+	if len(toSweep) == 0 {
+		return nil
+	}
+	// wait until the objects of all the tests whose diagnostics were not collected have been retained long enough
+	var deadline time.Time
+	for _, test := range toSweep {
+		if until := test.retainedAt.Add(retention); !test.released && until.After(deadline) {
+			deadline = until
+		}
+	}
+	if wait := time.Until(deadline); wait > 0 {
+		fmt.Printf("waiting %s before deleting the objects retained by the failed tests\n", wait.Round(time.Second))
+		time.Sleep(wait)
+	}
+
+	var tasks []*cleanTask
+	sweepers := make([]*sweepT, 0, len(toSweep))
+	for _, test := range toSweep {
+		fmt.Printf("deleting the objects retained by the test '%s':\n%s\n", test.name, manifest(test.tasks))
+		sweeper := &sweepT{name: test.name}
+		sweepers = append(sweepers, sweeper)
+		for _, task := range test.tasks {
+			// the test is over, so its testing.T can't be used anymore
+			task.t = sweeper
+			tasks = append(tasks, task)
+		}
+	}
+	executeCleanTasks(tasks)
+
+	var errs []error
+	for _, sweeper := range sweepers {
+		if sweeper.failed.Load() {
+			errs = append(errs, fmt.Errorf("failed to delete the objects retained by the test '%s'", sweeper.name))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// manifest returns the list of the objects of the tasks, one per line
+func manifest(tasks []*cleanTask) string {
+	lines := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		if task.objToClean == nil {
+			continue
+		}
+		name := task.objToClean.GetName()
+		if task.objToClean.GetNamespace() != "" {
+			name = task.objToClean.GetNamespace() + "/" + name
+		}
+		lines = append(lines, fmt.Sprintf("- %s %s", KindOf(task.objToClean), name))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
+
+// sweepT replaces the testing.T of a test whose objects are deleted once the test is over
+type sweepT struct {
+	name   string
+	failed atomic.Bool
+}
+
+func (s *sweepT) Logf(format string, args ...any) {
+	fmt.Printf("[%s] %s\n", s.name, fmt.Sprintf(format, args...))
+}
+
+func (s *sweepT) Log(args ...any) {
+	fmt.Printf("[%s] %s", s.name, fmt.Sprintln(args...))
+}
+
+func (s *sweepT) Errorf(format string, args ...any) {
+	s.failed.Store(true)
+	s.Logf(format, args...)
+}
+
+// FailNow stops the clean task, as testing.T does
+func (s *sweepT) FailNow() {
+	s.failed.Store(true)
+	runtime.Goexit()
+}
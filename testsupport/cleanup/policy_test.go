@@ -0,0 +1,147 @@
+package cleanup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestCleanupPolicy(t *testing.T) {
+	for value, expected := range map[string]string{
+		"":                           KeepOnFailureCleanupPolicy,
+		"always":                     AlwaysCleanupPolicy,
+		"never":                      NeverCleanupPolicy,
+		"keep-on-failure":            KeepOnFailureCleanupPolicy,
+		"keep-on-failure-then-sweep": KeepOnFailureThenSweepCleanupPolicy,
+		"unknown":                    KeepOnFailureCleanupPolicy,
+	} {
+		t.Run(value, func(t *testing.T) {
+			// given
+			t.Setenv(CleanupPolicyVar, value)
+
+			// when
+			policy := cleanupPolicy()
+
+			// then
+			assert.Equal(t, expected, policy)
+		})
+	}
+}
+
+func TestManifest(t *testing.T) {
+	// given
+	tasks := []*cleanTask{
+		newCleanTask(t, nil, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "user-dev"}}, time.Second),
+		newCleanTask(t, nil, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "user-dev"}}, time.Second),
+		newCleanTask(t, nil, nil, time.Second),
+	}
+
+	// when
+	result := manifest(tasks)
+
+	// then
+	assert.Equal(t, "- ConfigMap user-dev/config\n- Namespace user-dev", result)
+}
+
+func TestReleaseRetained(t *testing.T) {
+	// given
+	retaining.tests = []*retainedTest{{name: "TestFoo/sub"}, {name: "TestFooBar"}, {name: "TestFoo"}}
+	t.Cleanup(func() {
+		retaining.tests = nil
+	})
+
+	// when
+	ReleaseRetained("TestFoo")
+
+	// then
+	assert.True(t, retaining.tests[0].released)
+	assert.False(t, retaining.tests[1].released)
+	assert.True(t, retaining.tests[2].released)
+}
+
+func TestSweepRetained(t *testing.T) {
+	newConfigMap := func(name string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+	}
+	exists := func(t *testing.T, cl client.Client, name string) bool {
+		err := cl.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: name}, &corev1.ConfigMap{})
+		if apierrors.IsNotFound(err) {
+			return false
+		}
+		require.NoError(t, err)
+		return true
+	}
+
+	t.Run("deletes the swept objects once they are released", func(t *testing.T) {
+		// given
+		t.Cleanup(func() {
+			retaining.tests = nil
+		})
+		t.Setenv(CleanupRetentionVar, "1h")
+		cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(newConfigMap("swept"), newConfigMap("kept")).Build()
+		retaining.tests = []*retainedTest{
+			{
+				name:       "TestSwept",
+				retainedAt: time.Now(),
+				swept:      true,
+				released:   true,
+				tasks:      []*cleanTask{newCleanTask(t, cl, newConfigMap("swept"), time.Second)},
+			},
+			{
+				name:       "TestKept",
+				retainedAt: time.Now(),
+				tasks:      []*cleanTask{newCleanTask(t, cl, newConfigMap("kept"), time.Second)},
+			},
+		}
+
+		// when
+		err := SweepRetained()
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, exists(t, cl, "swept"))
+		assert.True(t, exists(t, cl, "kept"))
+		assert.Equal(t, []string{"TestKept"}, RetainedTests())
+	})
+
+	t.Run("waits for the retention duration", func(t *testing.T) {
+		// given
+		t.Setenv(CleanupRetentionVar, "200ms")
+		cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(newConfigMap("swept")).Build()
+		retaining.tests = []*retainedTest{{
+			name:       "TestSwept",
+			retainedAt: time.Now(),
+			swept:      true,
+			tasks:      []*cleanTask{newCleanTask(t, cl, newConfigMap("swept"), time.Second)},
+		}}
+		start := time.Now()
+
+		// when
+		err := SweepRetained()
+
+		// then
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+		assert.False(t, exists(t, cl, "swept"))
+	})
+
+	t.Run("invalid retention", func(t *testing.T) {
+		// given
+		t.Setenv(CleanupRetentionVar, "ten minutes")
+
+		// when
+		err := SweepRetained()
+
+		// then
+		require.EqualError(t, err, `invalid value of the CLEANUP_RETENTION env var: time: invalid duration "ten minutes"`)
+	})
+}
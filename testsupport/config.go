@@ -0,0 +1,402 @@
+package testsupport
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SuiteConfigVar is the name of the env var with the path of the YAML file which contains the configuration of the suite.
+// The values of the file are overridden by the env vars which are set (eg, HOST_NS, MEMBER_NS, SECOND_MEMBER_MODE).
+const SuiteConfigVar = "E2E_SUITE_CONFIG"
+
+const (
+	// IncludeTagsVar is the name of the env var with the comma-separated tags of the tests to run (see Requires)
+	IncludeTagsVar = "E2E_INCLUDE_TAGS"
+	// ExcludeTagsVar is the name of the env var with the comma-separated tags of the tests to skip (see Requires)
+	ExcludeTagsVar = "E2E_EXCLUDE_TAGS"
+)
+
+// SuiteConfig is the configuration of the e2e suite, eg:
+//
+//	namespaces:
+//	  host: toolchain-host-operator
+//	  registrationService: toolchain-host-operator
+//	clusters:
+//	  host:
+//	    context: host
+//	  members:
+//	  - namespace: toolchain-member-operator
+//	    context: member1
+//	  - namespace: toolchain-member2-operator
+//	    context: member2
+//	timeouts:
+//	  default: 3m
+//	replicas:
+//	  registrationService: 3
+//	features:
+//	  secondMember: true
+//	artifacts:
+//	  dir: /tmp/artifacts
+//	tags:
+//	  exclude:
+//	  - phone-verification
+type SuiteConfig struct {
+	Namespaces NamespacesConfig `json:"namespaces"`
+	Clusters   ClustersConfig   `json:"clusters"`
+	Timeouts   TimeoutsConfig   `json:"timeouts"`
+	Replicas   ReplicasConfig   `json:"replicas"`
+	Features   FeaturesConfig   `json:"features"`
+	Artifacts  ArtifactsConfig  `json:"artifacts"`
+	Tags       TagsConfig       `json:"tags"`
+}
+
+// NamespacesConfig contains the namespaces of the host operator and of the registration service
+type NamespacesConfig struct {
+	// Host is the namespace of the host operator (HOST_NS env var)
+	Host string `json:"host"`
+	// RegistrationService is the namespace of the registration service (REGISTRATION_SERVICE_NS env var)
+	RegistrationService string `json:"registrationService"`
+}
+
+// ClustersConfig contains the configuration of the host and member clusters
+type ClustersConfig struct {
+	Host ClusterConfig `json:"host"`
+	// Members are the member clusters, in the order in which they are exposed by the awaitilities. Only the first one is used when
+	// the second member mode is disabled.
+	Members []MemberClusterConfig `json:"members"`
+	// DiscoverMembers is true if the members are discovered from the ToolchainClusters of the host instead of being configured
+	// (MEMBER_DISCOVERY env var). In this case, the kubeconfig and the context of the discovered members are the ones of the
+	// configured members with the same name, and only the first one is used when the second member mode is disabled.
+	DiscoverMembers bool `json:"discoverMembers"`
+}
+
+// ClusterConfig contains the kubeconfig file and the context used to access a cluster. The default kubeconfig and its
+// current context are used when they are not set.
+type ClusterConfig struct {
+	// Kubeconfig is the path of the kubeconfig file (HOST_KUBECONFIG, MEMBER_KUBECONFIG, MEMBER_KUBECONFIG_2, etc. env vars)
+	Kubeconfig string `json:"kubeconfig,omitempty"`
+	// Context is the name of the context in the kubeconfig (HOST_KUBECONTEXT, MEMBER_KUBECONTEXT, MEMBER_KUBECONTEXT_2, etc. env vars)
+	Context string `json:"context,omitempty"`
+}
+
+// IsSet returns true if the kubeconfig file or the context of the cluster is set
+func (c ClusterConfig) IsSet() bool {
+	return c.Kubeconfig != "" || c.Context != ""
+}
+
+// MemberClusterConfig contains the configuration of a member cluster
+type MemberClusterConfig struct {
+	ClusterConfig `json:",inline"`
+	// Name is the name of the ToolchainCluster of the member in the host (MEMBER_NAME, MEMBER_NAME_2, etc. env vars).
+	// It's only used to pair the discovered members with their kubeconfig and context.
+	Name string `json:"name,omitempty"`
+	// Namespace is the namespace of the member operator (MEMBER_NS, MEMBER_NS_2, etc. env vars)
+	Namespace string `json:"namespace"`
+}
+
+// TimeoutsConfig contains the default timeout and retry interval of the awaitilities
+type TimeoutsConfig struct {
+	Default       metav1.Duration `json:"default"`
+	RetryInterval metav1.Duration `json:"retryInterval"`
+}
+
+// ReplicasConfig contains the number of replicas of the deployments which are expected to be ready
+type ReplicasConfig struct {
+	HostOperator        int `json:"hostOperator"`
+	MemberOperator      int `json:"memberOperator"`
+	RegistrationService int `json:"registrationService"`
+}
+
+// FeaturesConfig contains the features which are enabled in the suite
+type FeaturesConfig struct {
+	// SecondMember is true if the second member (and the next ones) are deployed (SECOND_MEMBER_MODE env var). It must be set.
+	SecondMember *bool `json:"secondMember"`
+	// Identity is the identity used by the tests to access the clusters (E2E_IDENTITY env var)
+	Identity string `json:"identity"`
+}
+
+// ArtifactsConfig contains the location of the artifacts of the suite
+type ArtifactsConfig struct {
+	// Dir is the directory in which the diagnostics and the reports are written (ARTIFACT_DIR env var)
+	Dir string `json:"dir"`
+}
+
+// TagsConfig contains the tags of the tests to run or to skip (see Requires). The tests without tags are always run.
+type TagsConfig struct {
+	// Include are the tags of the tests to run (E2E_INCLUDE_TAGS env var): when it's set, the tests run only if one of
+	// their tags is included
+	Include []string `json:"include,omitempty"`
+	// Exclude are the tags of the tests to skip (E2E_EXCLUDE_TAGS env var), even if they are included
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// ActiveMembers returns the configuration of the members which are used by the suite
+func (c *SuiteConfig) ActiveMembers() []MemberClusterConfig {
+	if !c.IsSecondMemberMode() && len(c.Clusters.Members) > 1 {
+		return c.Clusters.Members[:1]
+	}
+	return c.Clusters.Members
+}
+
+// discoveredMembers returns the configuration of the members of the given ToolchainClusters, sorted by name, with the kubeconfig
+// and the context of the configured members with the same name. Only the first one is returned when the second member mode is disabled.
+func (c *SuiteConfig) discoveredMembers(clusters []toolchainv1alpha1.ToolchainCluster) ([]MemberClusterConfig, error) {
+	configured := make(map[string]ClusterConfig, len(c.Clusters.Members))
+	for _, m := range c.Clusters.Members {
+		if m.Name != "" {
+			configured[m.Name] = m.ClusterConfig
+		}
+	}
+	members := make([]MemberClusterConfig, 0, len(clusters))
+	for _, cluster := range clusters {
+		if cluster.Status.OperatorNamespace == "" {
+			return nil, fmt.Errorf("the operator namespace of the ToolchainCluster '%s' is not set", cluster.Name)
+		}
+		members = append(members, MemberClusterConfig{
+			ClusterConfig: configured[cluster.Name],
+			Name:          cluster.Name,
+			Namespace:     cluster.Status.OperatorNamespace,
+		})
+		delete(configured, cluster.Name)
+	}
+	if len(configured) > 0 {
+		return nil, fmt.Errorf("no ToolchainCluster found for the configured members: %s", strings.Join(slices.Sorted(maps.Keys(configured)), ", "))
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Name < members[j].Name
+	})
+	minMembers := 1
+	if c.IsSecondMemberMode() {
+		minMembers = 2
+	}
+	if len(members) < minMembers {
+		return nil, fmt.Errorf("%d member clusters are needed, found %d ToolchainClusters", minMembers, len(members))
+	}
+	if !c.IsSecondMemberMode() {
+		return members[:1], nil
+	}
+	return members, nil
+}
+
+// IsSecondMemberMode returns true if the second member (and the next ones) are deployed
+func (c *SuiteConfig) IsSecondMemberMode() bool {
+	return c.Features.SecondMember != nil && *c.Features.SecondMember
+}
+
+// defaultSuiteConfig returns the configuration used when neither the file nor the env vars set the values
+func defaultSuiteConfig() *SuiteConfig {
+	return &SuiteConfig{
+		Timeouts: TimeoutsConfig{
+			Default:       metav1.Duration{Duration: wait.DefaultTimeout},
+			RetryInterval: metav1.Duration{Duration: wait.DefaultRetryInterval},
+		},
+		Replicas: ReplicasConfig{
+			HostOperator:        1,
+			MemberOperator:      1,
+			RegistrationService: 3,
+		},
+		Features: FeaturesConfig{
+			Identity: ClusterAdminE2EIdentity,
+		},
+	}
+}
+
+// LoadSuiteConfig returns the default configuration of the suite, overridden by the content of the YAML file at the given path
+// (if it's not empty), and then by the env vars which are set. It returns an error listing all the invalid values.
+func LoadSuiteConfig(path string) (*SuiteConfig, error) {
+	config := defaultSuiteConfig()
+	if path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the suite configuration file: %w", err)
+		}
+		if err := yaml.Unmarshal(content, config); err != nil {
+			return nil, fmt.Errorf("invalid suite configuration file '%s': %w", path, err)
+		}
+	}
+	if err := config.applyEnv(); err != nil {
+		return nil, err
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// applyEnv overrides the values of the configuration with the env vars which are set
+func (c *SuiteConfig) applyEnv() error {
+	setFromEnv := func(value *string, name string) {
+		if v := os.Getenv(name); v != "" {
+			*value = v
+		}
+	}
+	setFromEnv(&c.Namespaces.Host, wait.HostNsVar)
+	setFromEnv(&c.Namespaces.RegistrationService, wait.RegistrationServiceVar)
+	setFromEnv(&c.Clusters.Host.Kubeconfig, wait.HostKubeconfigVar)
+	setFromEnv(&c.Clusters.Host.Context, wait.HostKubecontextVar)
+	// the members are configured with the MEMBER_NS, MEMBER_NS_2, MEMBER_NS_3, etc. env vars, until one is missing
+	for i := 0; ; i++ {
+		set := false
+		for _, name := range []string{memberVar(wait.MemberNsVar, i), memberVar(wait.MemberNameVar, i), memberVar(wait.MemberKubeconfigVar, i), memberVar(wait.MemberKubecontextVar, i)} {
+			set = set || os.Getenv(name) != ""
+		}
+		if !set {
+			break
+		}
+		if i == len(c.Clusters.Members) {
+			c.Clusters.Members = append(c.Clusters.Members, MemberClusterConfig{})
+		}
+		setFromEnv(&c.Clusters.Members[i].Namespace, memberVar(wait.MemberNsVar, i))
+		setFromEnv(&c.Clusters.Members[i].Name, memberVar(wait.MemberNameVar, i))
+		setFromEnv(&c.Clusters.Members[i].Kubeconfig, memberVar(wait.MemberKubeconfigVar, i))
+		setFromEnv(&c.Clusters.Members[i].Context, memberVar(wait.MemberKubecontextVar, i))
+	}
+	setFromEnv(&c.Features.Identity, E2EIdentityVar)
+	setFromEnv(&c.Artifacts.Dir, wait.ArtifactDirVar)
+	setListFromEnv := func(value *[]string, name string) {
+		if v := os.Getenv(name); v != "" {
+			*value = nil
+			for _, item := range strings.Split(v, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					*value = append(*value, item)
+				}
+			}
+		}
+	}
+	setListFromEnv(&c.Tags.Include, IncludeTagsVar)
+	setListFromEnv(&c.Tags.Exclude, ExcludeTagsVar)
+
+	var errs []error
+	if v := os.Getenv(wait.MemberDiscoveryVar); v != "" {
+		discover, err := strconv.ParseBool(v)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid value of the %s env var: '%s' is not a boolean", wait.MemberDiscoveryVar, v))
+		}
+		c.Clusters.DiscoverMembers = discover
+	}
+	if v := os.Getenv(wait.SecondMemberModeVar); v != "" {
+		secondMember, err := strconv.ParseBool(v)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid value of the %s env var: '%s' is not a boolean", wait.SecondMemberModeVar, v))
+		}
+		c.Features.SecondMember = &secondMember
+	}
+	return errors.Join(errs...)
+}
+
+// memberVar returns the name of the env var of the member with the given index: the first member uses the given name (eg, `MEMBER_NS`)
+// and the next ones use the name suffixed with their position (eg, `MEMBER_NS_2`)
+func memberVar(name string, index int) string {
+	if index == 0 {
+		return name
+	}
+	return fmt.Sprintf("%s_%d", name, index+1)
+}
+
+// Validate returns an error listing all the invalid values of the configuration
+func (c *SuiteConfig) Validate() error {
+	var errs []error
+	invalid := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+	if c.Namespaces.Host == "" {
+		invalid("the namespace of the host operator must be set in 'namespaces.host' or in the %s env var", wait.HostNsVar)
+	}
+	if c.Namespaces.RegistrationService == "" {
+		invalid("the namespace of the registration service must be set in 'namespaces.registrationService' or in the %s env var", wait.RegistrationServiceVar)
+	}
+	if c.Features.SecondMember == nil {
+		invalid("the second member mode must be set in 'features.secondMember' or in the %s env var", wait.SecondMemberModeVar)
+	}
+	if !c.Clusters.DiscoverMembers {
+		minMembers := 1
+		if c.IsSecondMemberMode() {
+			minMembers = 2
+		}
+		if len(c.Clusters.Members) < minMembers {
+			invalid("%d member clusters must be set in 'clusters.members' or in the %s, %s, etc. env vars, got %d", minMembers, wait.MemberNsVar, wait.MemberNsVar2, len(c.Clusters.Members))
+		}
+		for i, m := range c.Clusters.Members {
+			if m.Namespace == "" {
+				invalid("the namespace of the member operator must be set in 'clusters.members[%d].namespace' or in the %s env var", i, memberVar(wait.MemberNsVar, i))
+			}
+		}
+	} else {
+		for i, m := range c.Clusters.Members {
+			if m.IsSet() && m.Name == "" {
+				invalid("the name of the ToolchainCluster of the member must be set in 'clusters.members[%d].name' or in the %s env var to discover it", i, memberVar(wait.MemberNameVar, i))
+			}
+		}
+	}
+	for _, timeout := range []struct {
+		name  string
+		value time.Duration
+	}{
+		{name: "timeouts.default", value: c.Timeouts.Default.Duration},
+		{name: "timeouts.retryInterval", value: c.Timeouts.RetryInterval.Duration},
+	} {
+		if timeout.value <= 0 {
+			invalid("'%s' must be a positive duration, got '%s'", timeout.name, timeout.value)
+		}
+	}
+	for _, replicas := range []struct {
+		name  string
+		value int
+	}{
+		{name: "replicas.hostOperator", value: c.Replicas.HostOperator},
+		{name: "replicas.memberOperator", value: c.Replicas.MemberOperator},
+		{name: "replicas.registrationService", value: c.Replicas.RegistrationService},
+	} {
+		if replicas.value < 1 {
+			invalid("'%s' must be at least 1, got %d", replicas.name, replicas.value)
+		}
+	}
+	if c.Features.Identity != ClusterAdminE2EIdentity && c.Features.Identity != ScopedE2EIdentity {
+		invalid("the identity in 'features.identity' or in the %s env var must be '%s' or '%s', got '%s'", E2EIdentityVar, ClusterAdminE2EIdentity, ScopedE2EIdentity, c.Features.Identity)
+	}
+	for _, tags := range []struct {
+		name   string
+		envVar string
+		values []string
+	}{
+		{name: "tags.include", envVar: IncludeTagsVar, values: c.Tags.Include},
+		{name: "tags.exclude", envVar: ExcludeTagsVar, values: c.Tags.Exclude},
+	} {
+		for _, tag := range tags.values {
+			if _, found := capabilityChecks[tag]; !found {
+				invalid("unknown tag '%s' in '%s' or in the %s env var, the known tags are: %s", tag, tags.name, tags.envVar, strings.Join(knownTags(), ", "))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid suite configuration:\n%w", errors.Join(errs...))
+	}
+	return nil
+}
+
+var loadSuiteConfig = sync.OnceValues(func() (*SuiteConfig, error) {
+	return LoadSuiteConfig(os.Getenv(SuiteConfigVar))
+})
+
+// GetSuiteConfig returns the configuration of the suite, loaded from the file in the E2E_SUITE_CONFIG env var and from the env
+// vars. It fails the test if the configuration is invalid.
+func GetSuiteConfig(t testing.TB) *SuiteConfig {
+	config, err := loadSuiteConfig()
+	require.NoError(t, err)
+	return config
+}
@@ -0,0 +1,292 @@
+package testsupport
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// clearSuiteEnv unsets the env vars of the suite configuration which may be set in the environment of the tests
+func clearSuiteEnv(t *testing.T) {
+	for _, name := range []string{wait.HostNsVar, wait.RegistrationServiceVar, wait.HostKubeconfigVar, wait.HostKubecontextVar,
+		wait.MemberNsVar, wait.MemberNsVar2, wait.MemberNameVar, wait.MemberNameVar2, wait.MemberKubeconfigVar, wait.MemberKubecontextVar, wait.MemberKubeconfigVar2,
+		wait.MemberKubecontextVar2, wait.MemberDiscoveryVar, wait.SecondMemberModeVar, E2EIdentityVar, wait.ArtifactDirVar, IncludeTagsVar, ExcludeTagsVar} {
+		t.Setenv(name, "")
+	}
+}
+
+func writeSuiteConfig(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "suite.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadSuiteConfig(t *testing.T) {
+	t.Run("from env vars", func(t *testing.T) {
+		// given
+		clearSuiteEnv(t)
+		t.Setenv(wait.HostNsVar, "host")
+		t.Setenv(wait.RegistrationServiceVar, "registration")
+		t.Setenv(wait.MemberNsVar, "member1")
+		t.Setenv(wait.MemberNsVar2, "member2")
+		t.Setenv(wait.MemberKubecontextVar2, "member2-context")
+		t.Setenv(wait.SecondMemberModeVar, "true")
+		t.Setenv(ExcludeTagsVar, "webhook, phone-verification,")
+
+		// when
+		config, err := LoadSuiteConfig("")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "host", config.Namespaces.Host)
+		assert.Equal(t, "registration", config.Namespaces.RegistrationService)
+		assert.Equal(t, []MemberClusterConfig{
+			{Namespace: "member1"},
+			{Namespace: "member2", ClusterConfig: ClusterConfig{Context: "member2-context"}},
+		}, config.ActiveMembers())
+		assert.True(t, config.IsSecondMemberMode())
+		assert.Equal(t, wait.DefaultTimeout, config.Timeouts.Default.Duration)
+		assert.Equal(t, 3, config.Replicas.RegistrationService)
+		assert.Equal(t, ClusterAdminE2EIdentity, config.Features.Identity)
+		assert.Equal(t, TagsConfig{Exclude: []string{TagWebhook, TagPhoneVerification}}, config.Tags)
+	})
+
+	t.Run("from file overridden by env vars", func(t *testing.T) {
+		// given
+		clearSuiteEnv(t)
+		path := writeSuiteConfig(t, `
+namespaces:
+  host: host
+  registrationService: registration
+clusters:
+  host:
+    context: host-context
+  members:
+  - namespace: member1
+    kubeconfig: /tmp/member1
+  - namespace: member2
+timeouts:
+  default: 3m
+replicas:
+  registrationService: 1
+features:
+  secondMember: true
+  identity: scoped
+artifacts:
+  dir: /tmp/artifacts
+`)
+		t.Setenv(wait.MemberNsVar, "other-member1")
+		t.Setenv(wait.SecondMemberModeVar, "false")
+
+		// when
+		config, err := LoadSuiteConfig(path)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, ClusterConfig{Context: "host-context"}, config.Clusters.Host)
+		assert.Equal(t, []MemberClusterConfig{
+			{Namespace: "other-member1", ClusterConfig: ClusterConfig{Kubeconfig: "/tmp/member1"}},
+		}, config.ActiveMembers())
+		assert.False(t, config.IsSecondMemberMode())
+		assert.Equal(t, 3*time.Minute, config.Timeouts.Default.Duration)
+		assert.Equal(t, wait.DefaultRetryInterval, config.Timeouts.RetryInterval.Duration)
+		assert.Equal(t, 1, config.Replicas.RegistrationService)
+		assert.Equal(t, 1, config.Replicas.HostOperator)
+		assert.Equal(t, ScopedE2EIdentity, config.Features.Identity)
+		assert.Equal(t, "/tmp/artifacts", config.Artifacts.Dir)
+	})
+
+	t.Run("discovered members", func(t *testing.T) {
+		// given
+		clearSuiteEnv(t)
+		t.Setenv(wait.HostNsVar, "host")
+		t.Setenv(wait.RegistrationServiceVar, "registration")
+		t.Setenv(wait.SecondMemberModeVar, "true")
+		t.Setenv(wait.MemberDiscoveryVar, "true")
+		t.Setenv(wait.MemberNameVar, "member1")
+		t.Setenv(wait.MemberNameVar2, "member2")
+		t.Setenv(wait.MemberKubecontextVar2, "member2-context")
+
+		// when
+		config, err := LoadSuiteConfig("")
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, config.Clusters.DiscoverMembers)
+		assert.Equal(t, []MemberClusterConfig{{Name: "member1"}, {Name: "member2", ClusterConfig: ClusterConfig{Context: "member2-context"}}}, config.Clusters.Members)
+	})
+
+	t.Run("discovered member without name", func(t *testing.T) {
+		// given
+		clearSuiteEnv(t)
+		t.Setenv(wait.HostNsVar, "host")
+		t.Setenv(wait.RegistrationServiceVar, "registration")
+		t.Setenv(wait.SecondMemberModeVar, "true")
+		t.Setenv(wait.MemberDiscoveryVar, "true")
+		t.Setenv(wait.MemberNameVar, "member1")
+		t.Setenv(wait.MemberKubecontextVar2, "member2-context")
+
+		// when
+		_, err := LoadSuiteConfig("")
+
+		// then
+		require.ErrorContains(t, err, "the name of the ToolchainCluster of the member must be set in 'clusters.members[1].name' or in the MEMBER_NAME_2 env var to discover it")
+	})
+
+	t.Run("invalid env var", func(t *testing.T) {
+		// given
+		clearSuiteEnv(t)
+		t.Setenv(wait.SecondMemberModeVar, "yes")
+
+		// when
+		_, err := LoadSuiteConfig("")
+
+		// then
+		require.EqualError(t, err, "invalid value of the SECOND_MEMBER_MODE env var: 'yes' is not a boolean")
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		// given
+		clearSuiteEnv(t)
+		path := writeSuiteConfig(t, `
+clusters:
+  members:
+  - kubeconfig: /tmp/member1
+timeouts:
+  default: -1s
+replicas:
+  memberOperator: 0
+features:
+  secondMember: true
+  identity: admin
+tags:
+  include:
+  - webhooks
+`)
+
+		// when
+		_, err := LoadSuiteConfig(path)
+
+		// then
+		require.EqualError(t, err, `invalid suite configuration:
+the namespace of the host operator must be set in 'namespaces.host' or in the HOST_NS env var
+the namespace of the registration service must be set in 'namespaces.registrationService' or in the REGISTRATION_SERVICE_NS env var
+2 member clusters must be set in 'clusters.members' or in the MEMBER_NS, MEMBER_NS_2, etc. env vars, got 1
+the namespace of the member operator must be set in 'clusters.members[0].namespace' or in the MEMBER_NS env var
+'timeouts.default' must be a positive duration, got '-1s'
+'replicas.memberOperator' must be at least 1, got 0
+the identity in 'features.identity' or in the E2E_IDENTITY env var must be 'cluster-admin' or 'scoped', got 'admin'
+unknown tag 'webhooks' in 'tags.include' or in the E2E_INCLUDE_TAGS env var, the known tags are: appstudio-tier, phone-verification, second-member, webhook`)
+	})
+
+	t.Run("second member mode not set", func(t *testing.T) {
+		// given
+		clearSuiteEnv(t)
+		t.Setenv(wait.HostNsVar, "host")
+		t.Setenv(wait.RegistrationServiceVar, "registration")
+		t.Setenv(wait.MemberNsVar, "member1")
+
+		// when
+		_, err := LoadSuiteConfig("")
+
+		// then
+		require.ErrorContains(t, err, "the second member mode must be set in 'features.secondMember' or in the SECOND_MEMBER_MODE env var")
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		// given
+		clearSuiteEnv(t)
+
+		// when
+		_, err := LoadSuiteConfig(filepath.Join(t.TempDir(), "missing.yaml"))
+
+		// then
+		require.ErrorContains(t, err, "unable to read the suite configuration file")
+	})
+}
+
+func TestDiscoveredMembers(t *testing.T) {
+	toolchainCluster := func(name, namespace string) toolchainv1alpha1.ToolchainCluster {
+		return toolchainv1alpha1.ToolchainCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status:     toolchainv1alpha1.ToolchainClusterStatus{OperatorNamespace: namespace},
+		}
+	}
+	clusters := []toolchainv1alpha1.ToolchainCluster{
+		toolchainCluster("member-b", "toolchain-member-operator-2"),
+		toolchainCluster("member-a", "toolchain-member-operator"),
+	}
+	config := func(secondMember bool, members ...MemberClusterConfig) *SuiteConfig {
+		return &SuiteConfig{
+			Clusters: ClustersConfig{Members: members, DiscoverMembers: true},
+			Features: FeaturesConfig{SecondMember: &secondMember},
+		}
+	}
+
+	t.Run("paired by name", func(t *testing.T) {
+		// given
+		c := config(true, MemberClusterConfig{Name: "member-b", ClusterConfig: ClusterConfig{Context: "b"}}, MemberClusterConfig{Name: "member-a", ClusterConfig: ClusterConfig{Context: "a"}})
+
+		// when
+		members, err := c.discoveredMembers(clusters)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []MemberClusterConfig{
+			{Name: "member-a", Namespace: "toolchain-member-operator", ClusterConfig: ClusterConfig{Context: "a"}},
+			{Name: "member-b", Namespace: "toolchain-member-operator-2", ClusterConfig: ClusterConfig{Context: "b"}},
+		}, members)
+	})
+
+	t.Run("first member only when the second member mode is disabled", func(t *testing.T) {
+		// given
+		c := config(false)
+
+		// when
+		members, err := c.discoveredMembers(clusters)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []MemberClusterConfig{{Name: "member-a", Namespace: "toolchain-member-operator"}}, members)
+	})
+
+	t.Run("not enough members", func(t *testing.T) {
+		// given
+		c := config(true)
+
+		// when
+		_, err := c.discoveredMembers(clusters[:1])
+
+		// then
+		require.EqualError(t, err, "2 member clusters are needed, found 1 ToolchainClusters")
+	})
+
+	t.Run("configured member not found", func(t *testing.T) {
+		// given
+		c := config(true, MemberClusterConfig{Name: "member-c", ClusterConfig: ClusterConfig{Context: "c"}})
+
+		// when
+		_, err := c.discoveredMembers(clusters)
+
+		// then
+		require.EqualError(t, err, "no ToolchainCluster found for the configured members: member-c")
+	})
+
+	t.Run("operator namespace not set", func(t *testing.T) {
+		// given
+		c := config(true)
+
+		// when
+		_, err := c.discoveredMembers([]toolchainv1alpha1.ToolchainCluster{toolchainCluster("member-a", "")})
+
+		// then
+		require.EqualError(t, err, "the operator namespace of the ToolchainCluster 'member-a' is not set")
+	})
+}
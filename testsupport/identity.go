@@ -0,0 +1,219 @@
+package testsupport
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	toolchaincommon "github.com/codeready-toolchain/toolchain-common/pkg/client"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// E2EIdentityVar is the name of the env var which contains the identity used by the tests to access the clusters
+const E2EIdentityVar = "E2E_IDENTITY"
+
+const (
+	// ClusterAdminE2EIdentity is the `e2e-test` service account bound to the `cluster-admin` ClusterRole (default)
+	ClusterAdminE2EIdentity = "cluster-admin"
+	// ScopedE2EIdentity is a service account of the run bound to the ClusterRole declared in e2e-scoped-clusterrole.yaml, with
+	// a short-lived token which is refreshed before it expires. The service account and its binding are deleted at the end
+	// of the suite (see RunSuite).
+	ScopedE2EIdentity = "scoped"
+)
+
+// scopedTokenExpiration is the duration of the tokens of the scoped identity, which are refreshed once 3/4 of it elapsed
+const scopedTokenExpiration = time.Hour
+
+//go:embed e2e-scoped-clusterrole.yaml
+var scopedClusterRoleManifest []byte
+
+// invalidNameChars are the characters which are not allowed in the names of the objects of the scoped identity
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// scopedIdentity is the scoped identity used in a cluster
+type scopedIdentity struct {
+	client             client.Client
+	rclient            *rest.RESTClient
+	namespace          string
+	serviceAccountName string
+	bindingName        string
+	// roleName is the name of the ClusterRole created by the run, if the ClusterRole declared in e2e-scoped-clusterrole.yaml
+	// didn't exist
+	roleName  string
+	tokenFile string
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+var scopedIdentities = struct {
+	sync.Mutex
+	identities []*scopedIdentity
+}{}
+
+// scopedClusterRole returns the ClusterRole declared in e2e-scoped-clusterrole.yaml
+func scopedClusterRole() (*rbacv1.ClusterRole, error) {
+	role := &rbacv1.ClusterRole{}
+	if err := yaml.Unmarshal(scopedClusterRoleManifest, role); err != nil {
+		return nil, fmt.Errorf("invalid ClusterRole in e2e-scoped-clusterrole.yaml: %w", err)
+	}
+	return role, nil
+}
+
+// scopedRunName returns the name of an object of the scoped identity, suffixed with the ID of the run (see wait.RunID), so that the
+// runs sharing a cluster don't revoke the permissions of each other when they delete their objects
+func scopedRunName(prefix string) string {
+	return strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(fmt.Sprintf("%s-%s", prefix, wait.RunID())), "-"), "-")
+}
+
+// useScopedE2EIdentity creates a service account of the run in the given namespace, binds it to the scoped ClusterRole and
+// configures the kubeconfig with its token, which is written in a file so that it's reloaded by the clients once it's refreshed.
+// The ClusterRole declared in e2e-scoped-clusterrole.yaml is used as-is if it already exists (eg, if it was created beforehand by
+// an admin), otherwise a ClusterRole specific to the run is created with its rules.
+func useScopedE2EIdentity(t testing.TB, kubeconfig *rest.Config, namespace string, apiConfig *api.Config, cl client.Client) {
+	serviceAccountName := scopedRunName("e2e-test")
+	ensureE2EServiceAccount(t, namespace, serviceAccountName, cl)
+
+	role, err := scopedClusterRole()
+	require.NoError(t, err)
+	var createdRoleName string
+	if err := cl.Get(context.TODO(), types.NamespacedName{Name: role.Name}, &rbacv1.ClusterRole{}); errors.IsNotFound(err) {
+		role.Name = scopedRunName(role.Name)
+		t.Logf("creating the ClusterRole '%s' of the scoped e2e identity", role.Name)
+		// the ClusterRole may have been created for another namespace of the same cluster
+		if err := cl.Create(context.TODO(), role); err != nil && !errors.IsAlreadyExists(err) {
+			require.NoError(t, err, "unable to create the ClusterRole '%s': the user of the kubeconfig must be allowed to create it, "+
+				"or the ClusterRole declared in e2e-scoped-clusterrole.yaml must be created beforehand", role.Name)
+		}
+		createdRoleName = role.Name
+	} else {
+		require.NoError(t, err)
+		t.Logf("using the existing ClusterRole '%s' for the scoped e2e identity", role.Name)
+	}
+
+	// the binding is specific to the namespace, since the host and the members may run in the same cluster
+	bindingName := scopedRunName(fmt.Sprintf("e2e-test-scoped-%s", namespace))
+	binding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: bindingName,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "ClusterRole",
+			Name:     role.Name,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      serviceAccountName,
+				Namespace: namespace,
+			},
+		},
+	}
+	if err := cl.Create(context.TODO(), binding); err != nil && !errors.IsAlreadyExists(err) {
+		require.NoError(t, err, "unable to create the ClusterRoleBinding '%s'", bindingName)
+	}
+
+	identity := &scopedIdentity{
+		client:             cl,
+		rclient:            newTokenRequestClient(t, apiConfig),
+		namespace:          namespace,
+		serviceAccountName: serviceAccountName,
+		bindingName:        bindingName,
+		roleName:           createdRoleName,
+		tokenFile:          filepath.Join(os.TempDir(), fmt.Sprintf("e2e-test-token-%s-%d", namespace, os.Getpid())),
+		done:               make(chan struct{}),
+	}
+	token, err := identity.refreshToken(context.TODO())
+	require.NoError(t, err, "Error in creating Token")
+	kubeconfig.BearerToken = token
+	kubeconfig.BearerTokenFile = identity.tokenFile
+
+	var ctx context.Context
+	ctx, identity.cancel = context.WithCancel(context.Background())
+	go identity.refreshTokenUntilDone(ctx)
+
+	scopedIdentities.Lock()
+	defer scopedIdentities.Unlock()
+	scopedIdentities.identities = append(scopedIdentities.identities, identity)
+}
+
+// refreshToken requests a new token for the service account and writes it in the token file
+func (i *scopedIdentity) refreshToken(ctx context.Context) (string, error) {
+	token, err := toolchaincommon.CreateTokenRequest(ctx, i.rclient, types.NamespacedName{Namespace: i.namespace, Name: i.serviceAccountName}, int(scopedTokenExpiration.Seconds()))
+	if err != nil {
+		return "", err
+	}
+	// the file is replaced at once, so that the clients never read a partial token
+	tmp := i.tokenFile + ".tmp"
+	if err := os.WriteFile(tmp, []byte(token), 0600); err != nil {
+		return "", err
+	}
+	return token, os.Rename(tmp, i.tokenFile)
+}
+
+// refreshTokenUntilDone refreshes the token once 3/4 of its duration elapsed, until the context is cancelled. If the refresh fails,
+// then it's retried every minute until the token expires.
+func (i *scopedIdentity) refreshTokenUntilDone(ctx context.Context) {
+	defer close(i.done)
+	next := scopedTokenExpiration * 3 / 4
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(next):
+		}
+		if _, err := i.refreshToken(ctx); err != nil {
+			fmt.Printf("unable to refresh the token of the '%s' service account in the '%s' namespace: %s\n", i.serviceAccountName, i.namespace, err)
+			next = time.Minute
+			continue
+		}
+		next = scopedTokenExpiration * 3 / 4
+	}
+}
+
+// deleteScopedIdentities stops refreshing the tokens of the scoped identities, and deletes their service accounts, bindings
+// and the ClusterRoles created by the run
+func deleteScopedIdentities() error {
+	scopedIdentities.Lock()
+	identities := scopedIdentities.identities
+	scopedIdentities.identities = nil
+	scopedIdentities.Unlock()
+
+	var errs []error
+	for _, i := range identities {
+		i.cancel()
+		<-i.done
+		_ = os.Remove(i.tokenFile)
+		objs := []client.Object{
+			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: i.bindingName}},
+			&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: i.namespace, Name: i.serviceAccountName}},
+		}
+		if i.roleName != "" {
+			objs = append(objs, &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: i.roleName}})
+		}
+		for _, obj := range objs {
+			if err := i.client.Delete(context.TODO(), obj); err != nil && !errors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("unable to delete the %T '%s' of the scoped e2e identity: %w", obj, obj.GetName(), err))
+			}
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,101 @@
+package testsupport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestScopedClusterRole(t *testing.T) {
+	// when
+	role, err := scopedClusterRole()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "e2e-test-scoped", role.Name)
+	require.NotEmpty(t, role.Rules)
+	for _, rule := range role.Rules {
+		assert.NotEmpty(t, rule.Verbs)
+		assert.NotContains(t, rule.Verbs, "*", "the scoped ClusterRole must list the verbs explicitly")
+	}
+}
+
+func TestScopedRunName(t *testing.T) {
+	// when
+	name := scopedRunName("e2e-test-scoped-toolchain-host-operator")
+
+	// then
+	assert.Equal(t, "e2e-test-scoped-toolchain-host-operator-"+wait.RunID(), name)
+	assert.Regexp(t, `^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`, name)
+}
+
+func TestDeleteScopedIdentities(t *testing.T) {
+	// newIdentity returns a scoped identity of the host namespace whose objects are in the given client
+	newIdentity := func(t *testing.T, cl client.Client, roleName string) *scopedIdentity {
+		// the refresh of the token stops when it's cancelled
+		done := make(chan struct{})
+		return &scopedIdentity{
+			client:             cl,
+			namespace:          "host",
+			serviceAccountName: "e2e-test-my-run",
+			bindingName:        "e2e-test-scoped-host-my-run",
+			roleName:           roleName,
+			tokenFile:          t.TempDir() + "/token",
+			cancel: func() {
+				close(done)
+			},
+			done: done,
+		}
+	}
+	binding := &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "e2e-test-scoped-host-my-run"}}
+	sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: "host", Name: "e2e-test-my-run"}}
+	otherRunSA := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: "host", Name: "e2e-test-other-run"}}
+
+	t.Run("ClusterRole created by the run", func(t *testing.T) {
+		// given
+		role := &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "e2e-test-scoped-my-run"}}
+		cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(role, binding.DeepCopy(), sa.DeepCopy(), otherRunSA.DeepCopy()).Build()
+		scopedIdentities.identities = []*scopedIdentity{newIdentity(t, cl, role.Name)}
+
+		// when
+		err := deleteScopedIdentities()
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, scopedIdentities.identities)
+		for _, obj := range []client.Object{role, binding.DeepCopy(), sa.DeepCopy()} {
+			err := cl.Get(context.TODO(), client.ObjectKeyFromObject(obj), obj)
+			assert.True(t, errors.IsNotFound(err), "%T '%s' was not deleted", obj, obj.GetName())
+		}
+		require.NoError(t, cl.Get(context.TODO(), client.ObjectKeyFromObject(otherRunSA), &corev1.ServiceAccount{}))
+	})
+
+	t.Run("existing ClusterRole", func(t *testing.T) {
+		// given
+		role, err := scopedClusterRole()
+		require.NoError(t, err)
+		cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(role, binding.DeepCopy(), sa.DeepCopy()).Build()
+		scopedIdentities.identities = []*scopedIdentity{newIdentity(t, cl, "")}
+
+		// when
+		err = deleteScopedIdentities()
+
+		// then
+		require.NoError(t, err)
+		for _, obj := range []client.Object{binding.DeepCopy(), sa.DeepCopy()} {
+			err := cl.Get(context.TODO(), client.ObjectKeyFromObject(obj), obj)
+			assert.True(t, errors.IsNotFound(err), "%T '%s' was not deleted", obj, obj.GetName())
+		}
+		require.NoError(t, cl.Get(context.TODO(), client.ObjectKeyFromObject(role), &rbacv1.ClusterRole{}), "the ClusterRole which was not created by the run must be kept")
+	})
+}
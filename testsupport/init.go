@@ -44,10 +44,21 @@ import (
 )
 
 var (
-	initHostAwait    *wait.HostAwaitility
-	initMemberAwait  *wait.MemberAwaitility
-	initMember2Await *wait.MemberAwaitility
+	initHostAwait *wait.HostAwaitility
+	// initMemberAwaits contains the awaitilities of the member clusters, in the order in which they were configured
+	initMemberAwaits []*wait.MemberAwaitility
 	initOnce         sync.Once
+	// initSnapshot contains the objects which existed before the tests of the package were executed, to find the objects
+	// which they leaked (see RunSuite)
+	initSnapshot *wait.ObjectsSnapshot
+
+	// retryOptions are the default retry options of the awaitilities of the suite, which make the waits on the objects taking
+	// a long time to reach their expected state (eg, the Namespaces being terminated) less aggressive, and the waits on the objects
+	// which are quickly updated by the operators (eg, the status of the UserSignups) more responsive
+	retryOptions = []wait.RetryOption{
+		wait.KindBackoff{Kind: "Namespace", Backoff: wait.Backoff{Initial: 500 * time.Millisecond, Factor: 2, Cap: 5 * time.Second, Jitter: 0.2}},
+		wait.KindBackoff{Kind: "UserSignup", Backoff: wait.Backoff{Initial: wait.DefaultRetryInterval, Factor: 1.5, Cap: 500 * time.Millisecond, Jitter: 0.1}},
+	}
 )
 
 // WaitForOperators initializes test context, registers schemes and waits until both operators (host, member)
@@ -55,44 +66,42 @@ var (
 // the e2e test it retrieves namespace names. Also waits for the registration service to be deployed (with 3 replica)
 // Returns the test context and an instance of Awaitility that contains all necessary information
 func WaitForOperators(t *testing.T) wait.Awaitilities {
+	// only the test which verifies the readiness of the operators fails if they are not ready, the next ones are skipped
+	operatorsReady := true
 	initOnce.Do(func() {
-		waitForOperators(t)
+		operatorsReady = checkPrerequisite(t, PrerequisiteOperators, nil, waitForOperators)
+		if operatorsReady {
+			recordEnvironment(wait.NewAwaitilities(initHostAwait, initMemberAwaits...))
+		}
+		logPreflightReport(t)
 	})
-	return wait.NewAwaitilities(initHostAwait, initMemberAwait, initMember2Await)
+	if !operatorsReady {
+		t.Fatalf("the '%s' prerequisite failed, see the preflight report", PrerequisiteOperators)
+	}
+	RequirePrerequisites(t, PrerequisiteOperators)
+	awaitilities := wait.NewAwaitilities(initHostAwait, initMemberAwaits...)
+	wait.CollectDiagnosticsOnFailure(t, awaitilities)
+	return awaitilities
 }
-func waitForOperators(t *testing.T) {
-	memberNs := os.Getenv(wait.MemberNsVar)
-	memberNs2 := os.Getenv(wait.MemberNsVar2)
-	hostNs := os.Getenv(wait.HostNsVar)
-	registrationServiceNs := os.Getenv(wait.RegistrationServiceVar)
+func waitForOperators(t testing.TB) {
+	config := GetSuiteConfig(t)
+	wait.SetArtifactDir(config.Artifacts.Dir)
+	hostNs := config.Namespaces.Host
+	registrationServiceNs := config.Namespaces.RegistrationService
 	t.Logf("Host Operator namespace: %s", hostNs)
-	t.Logf("Member1 Operator namespace: %s", memberNs)
-	if IsSecondMemberMode(t) {
-		t.Logf("Member2 Operator namespace: %s", memberNs2)
-	}
 	t.Logf("Registration Service namespace: %s", registrationServiceNs)
 
-	apiConfig, err := clientcmd.NewDefaultClientConfigLoadingRules().Load()
-	require.NoError(t, err)
+	cl, kubeconfig := clusterClient(t, config, "host", config.Clusters.Host, hostNs)
 
-	kubeconfig, err := util.BuildKubernetesRESTConfig(*apiConfig)
-	require.NoError(t, err)
-
-	cl, err := client.New(kubeconfig, client.Options{
-		Scheme: schemeWithAllAPIs(t),
-	})
-	require.NoError(t, err)
-
-	//updating the kubeconfig with the bearer token created
-	kubeconfig.BearerToken = getE2EServiceAccountToken(t, hostNs, apiConfig, cl)
-
-	initHostAwait = wait.NewHostAwaitility(kubeconfig, cl, hostNs, registrationServiceNs)
+	initHostAwait = wait.NewHostAwaitility(kubeconfig, cl, hostNs, registrationServiceNs).WithRetryOptions(retryOptions...)
+	initHostAwait.Timeout = config.Timeouts.Default.Duration
+	initHostAwait.RetryInterval = config.Timeouts.RetryInterval.Duration
 
 	// wait for host operator to be ready
-	initHostAwait.WaitForDeploymentToGetReady(t, "host-operator-controller-manager", 1)
+	initHostAwait.WaitForDeploymentToGetReady(t, "host-operator-controller-manager", config.Replicas.HostOperator)
 
 	// wait for registration service to be ready
-	initHostAwait.WaitForDeploymentToGetReady(t, "registration-service", 3)
+	initHostAwait.WaitForDeploymentToGetReady(t, "registration-service", config.Replicas.RegistrationService)
 
 	// set registration service values
 	registrationServiceRoute, err := initHostAwait.WaitForRouteToBeAvailable(t, registrationServiceNs, "registration-service", "/")
@@ -105,47 +114,82 @@ func waitForOperators(t *testing.T) {
 	initHostAwait.RegistrationServiceURL = registrationServiceURL
 
 	// wait for member operators to be ready
-	initMemberAwait = getMemberAwaitility(t, initHostAwait, kubeconfig, memberNs)
-
-	_, err = initMemberAwait.WaitForToolchainClusterWithCondition(t, initHostAwait.Namespace, toolchainv1alpha1.ConditionReady)
-	require.NoError(t, err)
-
-	if IsSecondMemberMode(t) {
-		initMember2Await = getMemberAwaitility(t, initHostAwait, kubeconfig, memberNs2)
+	initMemberAwaits = nil
+	for i, member := range memberClusters(t, config, initHostAwait) {
+		t.Logf("Member%d Operator namespace: %s", i+1, member.Namespace)
+		memberKubeconfig := kubeconfig
+		if member.IsSet() {
+			_, memberKubeconfig = clusterClient(t, config, fmt.Sprintf("member%d", i+1), member.ClusterConfig, member.Namespace)
+		}
+		memberAwait := getMemberAwaitility(t, config, initHostAwait, memberKubeconfig, member.Namespace)
 
-		_, err = initMember2Await.WaitForToolchainClusterWithCondition(t, initHostAwait.Namespace, toolchainv1alpha1.ConditionReady)
+		_, err = memberAwait.WaitForToolchainClusterWithCondition(t, initHostAwait.Namespace, toolchainv1alpha1.ConditionReady)
 		require.NoError(t, err)
+		initMemberAwaits = append(initMemberAwaits, memberAwait)
 	}
 	t.Log("all operators are ready and in running state")
+
+	initSnapshot, err = wait.TakeObjectsSnapshot(wait.NewAwaitilities(initHostAwait, initMemberAwaits...))
+	require.NoError(t, err)
+	t.Logf("objects existing before the tests: %v", initSnapshot.Counts())
 }
 
-func getE2EServiceAccountToken(t *testing.T, hostNs string, apiConfigsa *api.Config, sacl client.Client) string {
-	// creating another config which is used for creating only resclient,
-	//so that the main kubeconfig is not altered
-	restkubeconfig, err := util.BuildKubernetesRESTConfig(*apiConfigsa)
+// loadClusterConfig loads the kubeconfig file of the cluster (or the default kubeconfig if it's not set), and selects the context
+// of the cluster (or the current context if it's not set)
+func loadClusterConfig(t testing.TB, cluster ClusterConfig) *api.Config {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if cluster.Kubeconfig != "" {
+		loadingRules.ExplicitPath = cluster.Kubeconfig
+	}
+	apiConfig, err := loadingRules.Load()
+	require.NoError(t, err, "failed to load the kubeconfig '%s'", cluster.Kubeconfig)
+	if cluster.Context != "" {
+		require.Contains(t, apiConfig.Contexts, cluster.Context, "the context '%s' doesn't exist in the kubeconfig", cluster.Context)
+		apiConfig.CurrentContext = cluster.Context
+	}
+	return apiConfig
+}
+
+// clusterClient returns a client and the REST config of the cluster, both authenticated with the token of the e2e service account,
+// which is created in the given namespace of the cluster with the credentials of the kubeconfig
+func clusterClient(t testing.TB, config *SuiteConfig, name string, cluster ClusterConfig, namespace string) (client.Client, *rest.Config) {
+	apiConfig := loadClusterConfig(t, cluster)
+	kubeconfig, err := util.BuildKubernetesRESTConfig(*apiConfig)
 	require.NoError(t, err)
+	t.Logf("%s cluster: %s", name, kubeconfig.Host)
 
-	sa := &corev1.ServiceAccount{}
-	err = sacl.Get(context.TODO(), types.NamespacedName{Namespace: hostNs, Name: "e2e-test"}, sa)
-	// If not found proceed to create the e2e service account and the cluster role binding
-	if errors.IsNotFound(err) {
-		t.Logf("No Service Account for e2e test found, proceeding to create it")
-		err := sacl.Create(context.TODO(), &corev1.ServiceAccount{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "e2e-test",
-				Namespace: hostNs}})
-		require.NoError(t, err, "Error in creating Service account for e2e test")
-	} else if err != nil {
-		require.NoError(t, err, "Error fetching service accounts")
+	// the client with the credentials of the kubeconfig is only used to provision the e2e identity
+	adminCl, err := client.New(kubeconfig, client.Options{
+		Scheme: schemeWithAllAPIs(t),
+	})
+	require.NoError(t, err)
+
+	//updating the kubeconfig with the bearer token created
+	if config.Features.Identity == ScopedE2EIdentity {
+		useScopedE2EIdentity(t, kubeconfig, namespace, apiConfig, adminCl)
+	} else {
+		kubeconfig.BearerToken = getE2EServiceAccountToken(t, namespace, apiConfig, adminCl)
 	}
 
+	cl, err := client.New(kubeconfig, client.Options{
+		Scheme: schemeWithAllAPIs(t),
+	})
+	require.NoError(t, err)
+	return cl, kubeconfig
+}
+
+func getE2EServiceAccountToken(t testing.TB, namespace string, apiConfigsa *api.Config, sacl client.Client) string {
+	ensureE2EServiceAccount(t, namespace, "e2e-test", sacl)
+
+	// the binding is specific to the namespace, since the host and the members may run in the same cluster
+	bindingName := fmt.Sprintf("e2e-test-cluster-admin-%s", namespace)
 	sacrb := &rbacv1.ClusterRoleBinding{}
-	err = sacl.Get(context.TODO(), types.NamespacedName{Name: "e2e-test-cluster-admin"}, sacrb)
+	err := sacl.Get(context.TODO(), types.NamespacedName{Name: bindingName}, sacrb)
 	// check if there are any clusterrolebinding present from the previous run of e2e test
 	if errors.IsNotFound(err) {
 		crb := rbacv1.ClusterRoleBinding{
 			ObjectMeta: metav1.ObjectMeta{
-				Name: "e2e-test-cluster-admin",
+				Name: bindingName,
 			},
 			RoleRef: rbacv1.RoleRef{
 				APIGroup: "rbac.authorization.k8s.io",
@@ -156,7 +200,7 @@ func getE2EServiceAccountToken(t *testing.T, hostNs string, apiConfigsa *api.Con
 				{
 					Kind:      "ServiceAccount",
 					Name:      "e2e-test",
-					Namespace: hostNs,
+					Namespace: namespace,
 				},
 			},
 		}
@@ -167,6 +211,36 @@ func getE2EServiceAccountToken(t *testing.T, hostNs string, apiConfigsa *api.Con
 		require.NoError(t, err, "Error fetching clusterrolebinding")
 	}
 
+	//Creating a bearer token to be used for authentication(which is valid for 24 hrs)
+	bt, err := toolchaincommon.CreateTokenRequest(context.TODO(), newTokenRequestClient(t, apiConfigsa), types.NamespacedName{Namespace: namespace, Name: "e2e-test"}, 86400)
+	require.NoError(t, err, "Error in creating Token")
+	return bt
+}
+
+// ensureE2EServiceAccount creates the e2e service account with the given name in the given namespace, unless it already exists
+func ensureE2EServiceAccount(t testing.TB, namespace, name string, sacl client.Client) {
+	sa := &corev1.ServiceAccount{}
+	err := sacl.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, sa)
+	// If not found proceed to create the e2e service account
+	if errors.IsNotFound(err) {
+		t.Logf("No Service Account for e2e test found, proceeding to create it")
+		err := sacl.Create(context.TODO(), &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace}})
+		require.NoError(t, err, "Error in creating Service account for e2e test")
+	} else if err != nil {
+		require.NoError(t, err, "Error fetching service accounts")
+	}
+}
+
+// newTokenRequestClient returns the client used to request the tokens of the service accounts
+func newTokenRequestClient(t testing.TB, apiConfigsa *api.Config) *rest.RESTClient {
+	// creating another config which is used for creating only resclient,
+	//so that the main kubeconfig is not altered
+	restkubeconfig, err := util.BuildKubernetesRESTConfig(*apiConfigsa)
+	require.NoError(t, err)
+
 	//upating the restkubeconfig ,which requires groupversion to create restclient
 	restkubeconfig.ContentConfig =
 		rest.ContentConfig{
@@ -177,11 +251,7 @@ func getE2EServiceAccountToken(t *testing.T, hostNs string, apiConfigsa *api.Con
 	//Creating a Restclient to be used in creation and checking of bearer token required for authentication
 	rclient, err := rest.RESTClientFor(restkubeconfig)
 	require.NoError(t, err, "Error in creating restclient")
-
-	//Creating a bearer token to be used for authentication(which is valid for 24 hrs)
-	bt, err := toolchaincommon.CreateTokenRequest(context.TODO(), rclient, types.NamespacedName{Namespace: hostNs, Name: "e2e-test"}, 86400)
-	require.NoError(t, err, "Error in creating Token")
-	return bt
+	return rclient
 }
 
 // WaitForDeployments waits for all member Webhooks and autoscaling buffer apps in addition to waiting for
@@ -190,11 +260,32 @@ func getE2EServiceAccountToken(t *testing.T, hostNs string, apiConfigsa *api.Con
 // The primary reason for separation is because the migration tests are for testing host operator and member operator changes related to Spaces, NSTemplateTiers, etc.
 // Webhooks and autoscaling buffers do not deal with the same set of resources so they can be verified independently of migration tests
 func WaitForDeployments(t *testing.T) wait.Awaitilities {
+	// only the test which verifies the readiness of the operators fails if they are not ready, the next ones are skipped
+	operatorsReady := true
 	initOnce.Do(func() {
-		waitForOperators(t)
-		// wait for host and member operators to be ready
-		registrationServiceNs := os.Getenv(wait.RegistrationServiceVar)
+		operatorsReady = checkPrerequisite(t, PrerequisiteOperators, nil, waitForOperators)
+		if operatorsReady {
+			verifyDeployments(t)
+			recordEnvironment(wait.NewAwaitilities(initHostAwait, initMemberAwaits...))
+		}
+		logPreflightReport(t)
+	})
+	if !operatorsReady {
+		t.Fatalf("the '%s' prerequisite failed, see the preflight report", PrerequisiteOperators)
+	}
+	RequirePrerequisites(t, PrerequisiteOperators)
+	awaitilities := wait.NewAwaitilities(initHostAwait, initMemberAwaits...)
+	wait.CollectDiagnosticsOnFailure(t, awaitilities)
+	return awaitilities
+}
+
+// verifyDeployments verifies the prerequisites of the tests in addition to the readiness of the operators: each prerequisite
+// is verified even if the previous ones failed, so that the tests which don't depend on them can still be executed
+func verifyDeployments(t *testing.T) {
+	operators := []string{PrerequisiteOperators}
+	registrationServiceNs := GetSuiteConfig(t).Namespaces.RegistrationService
 
+	checkPrerequisite(t, PrerequisiteAPIProxy, operators, func(t testing.TB) {
 		// set api proxy values
 		apiRoute, err := initHostAwait.WaitForRouteToBeAvailable(t, registrationServiceNs, "api", "/proxyhealth")
 		require.NoError(t, err)
@@ -204,7 +295,9 @@ func WaitForDeployments(t *testing.T) wait.Awaitilities {
 		// wait for proxy metrics service
 		_, err = initHostAwait.WaitForService(t, "proxy-metrics-service")
 		require.NoError(t, err, "failed to find proxy metrics service")
+	})
 
+	checkPrerequisite(t, PrerequisiteMetricsRoutes, operators, func(t testing.TB) {
 		// setup host metrics route for metrics verification in tests
 		hostMetricsRoute, err := initHostAwait.SetupRouteForService(t, "host-operator-metrics-service", "/metrics",
 			&routev1.RoutePort{
@@ -233,29 +326,9 @@ func WaitForDeployments(t *testing.T) wait.Awaitilities {
 		initHostAwait.RegistrationServiceMetricsURL = "https://" + registrationServiceMetricsRoute.Status.Ingress[0].Host
 		t.Logf("registration service metrics URL: %s", initHostAwait.RegistrationServiceMetricsURL)
 
-		// setup member metrics route for metrics verification in tests
-		memberMetricsRoute, err := initMemberAwait.SetupRouteForService(t, "member-operator-metrics-service", "/metrics",
-			&routev1.RoutePort{
-				TargetPort: intstr.FromString("https"),
-			},
-			&routev1.TLSConfig{
-				Termination: routev1.TLSTerminationPassthrough,
-			},
-		)
-		require.NoError(t, err, "failed while setting up or waiting for the route to the 'member-operator-metrics' service to be available")
-		require.NotEmpty(t, memberMetricsRoute.Status.Ingress, "route has no ingress status for member metrics service")
-		initMemberAwait.MetricsURL = "https://" + memberMetricsRoute.Status.Ingress[0].Host
-		t.Logf("member metrics URL: %s", initMemberAwait.MetricsURL)
-
-		// Wait for the webhooks in Member 1 only because we do not deploy webhooks for Member 2
-		// (we can't deploy the same webhook multiple times on the same cluster)
-		// Also verify the autoscaling buffer in both members
-		webhookImage := initMemberAwait.GetContainerEnv(t, "MEMBER_OPERATOR_WEBHOOK_IMAGE")
-		require.NotEmpty(t, webhookImage, "The value of the env var MEMBER_OPERATOR_WEBHOOK_IMAGE wasn't found in the deployment of the member operator.")
-		if IsSecondMemberMode(t) {
-			err = initMember2Await.WaitUntilWebhookDeleted(t) // webhook on member2 should be deleted
-			require.NoError(t, err)
-			memberMetricsRoute, err := initMember2Await.SetupRouteForService(t, "member-operator-metrics-service", "/metrics",
+		// setup member metrics routes for metrics verification in tests
+		for _, memberAwait := range initMemberAwaits {
+			memberMetricsRoute, err := memberAwait.SetupRouteForService(t, "member-operator-metrics-service", "/metrics",
 				&routev1.RoutePort{
 					TargetPort: intstr.FromString("https"),
 				},
@@ -265,43 +338,71 @@ func WaitForDeployments(t *testing.T) wait.Awaitilities {
 			)
 			require.NoError(t, err, "failed while setting up or waiting for the route to the 'member-operator-metrics' service to be available")
 			require.NotEmpty(t, memberMetricsRoute.Status.Ingress, "route has no ingress status for member metrics service")
-			initMember2Await.MetricsURL = "https://" + memberMetricsRoute.Status.Ingress[0].Host
-			t.Logf("member metrics URL: %s", initMember2Await.MetricsURL)
+			memberAwait.MetricsURL = "https://" + memberMetricsRoute.Status.Ingress[0].Host
+			t.Logf("%s metrics URL: %s", memberAwait.ClusterName, memberAwait.MetricsURL)
+		}
+	})
 
+	// Wait for the webhooks in the first member only because we do not deploy webhooks for the other members
+	// (we can't deploy the same webhook multiple times on the same cluster)
+	if checkPrerequisite(t, PrerequisiteWebhook, operators, func(t testing.TB) {
+		webhookImage := initMemberAwaits[0].GetContainerEnv(t, "MEMBER_OPERATOR_WEBHOOK_IMAGE")
+		require.NotEmpty(t, webhookImage, "The value of the env var MEMBER_OPERATOR_WEBHOOK_IMAGE wasn't found in the deployment of the member operator.")
+		for _, memberAwait := range initMemberAwaits[1:] {
+			err := memberAwait.WaitUntilWebhookDeleted(t) // webhook on the other members should be deleted
+			require.NoError(t, err)
 		}
-		initMemberAwait.WaitForMemberWebhooks(t, webhookImage)
+		initMemberAwaits[0].WaitForMemberWebhooks(t, webhookImage)
+	}) {
+		initMemberAwaits[0].AddCapabilities(wait.WebhookCapability)
+	}
 
-		// wait for autoscaler buffer apps
-		initMemberAwait.WaitForAutoscalingBufferApp(t)
-		if IsSecondMemberMode(t) {
-			initMember2Await.WaitForAutoscalingBufferApp(t)
+	// Also verify the autoscaling buffer in all the members
+	if checkPrerequisite(t, PrerequisiteAutoscalingBuffer, operators, func(t testing.TB) {
+		for _, memberAwait := range initMemberAwaits {
+			memberAwait.WaitForAutoscalingBufferApp(t)
 		}
+	}) {
+		for _, memberAwait := range initMemberAwaits {
+			memberAwait.AddCapabilities(wait.AutoscalingBufferCapability)
+		}
+	}
 
+	checkPrerequisite(t, PrerequisiteTiers, operators, func(t testing.TB) {
 		// check that the tier exists, and all its namespace other cluster-scoped resource revisions
 		// are different from `000000a` which is the value specified in the initial manifest (used for base tier)
-		err = initHostAwait.WaitUntilBaseNSTemplateTierIsUpdated(t)
+		err := initHostAwait.WaitUntilBaseNSTemplateTierIsUpdated(t)
 		require.NoError(t, err)
 
 		// check that the default user tier exists and is updated to the current version, an outdated version is applied from deploy/e2e-tests/usertier-base.yaml as
 		// part of the e2e test setup make target for the purpose of verifying the user tier update mechanism on startup of the host operator
 		err = initHostAwait.WaitUntilBaseUserTierIsUpdated(t)
 		require.NoError(t, err)
+	})
 
+	checkPrerequisite(t, PrerequisiteToolchainStatus, operators, func(t testing.TB) {
 		// wait until the controller has started, counter is initialized, and ToolchainStatus is updated & ready
-		_, err = initHostAwait.WaitForToolchainStatus(t,
+		_, err := initHostAwait.WaitForToolchainStatus(t,
 			wait.UntilToolchainStatusHasConditions(wait.ToolchainStatusReadyAndUnreadyNotificationNotCreated()...),
 			wait.UntilToolchainStatusUpdatedAfter(time.Now()))
 		require.NoError(t, err)
 	})
+}
 
-	if !IsSecondMemberMode(t) {
-		return wait.NewAwaitilities(initHostAwait, initMemberAwait)
+// memberClusters returns the configuration of the member clusters: the ones of the ToolchainClusters of the host when the discovery
+// of the members is enabled (along with the kubeconfig and context of the configured member with the same name), or else the configured ones
+func memberClusters(t testing.TB, config *SuiteConfig, hostAwait *wait.HostAwaitility) []MemberClusterConfig {
+	if !config.Clusters.DiscoverMembers {
+		return config.ActiveMembers()
 	}
-
-	return wait.NewAwaitilities(initHostAwait, initMemberAwait, initMember2Await)
+	clusters := &toolchainv1alpha1.ToolchainClusterList{}
+	require.NoError(t, hostAwait.Client.List(context.TODO(), clusters, client.InNamespace(hostAwait.Namespace)))
+	members, err := config.discoveredMembers(clusters.Items)
+	require.NoError(t, err, "unable to discover the members from the ToolchainClusters in the '%s' namespace", hostAwait.Namespace)
+	return members
 }
 
-func getMemberAwaitility(t *testing.T, hostAwait *wait.HostAwaitility, restconfig *rest.Config, namespace string) *wait.MemberAwaitility {
+func getMemberAwaitility(t testing.TB, config *SuiteConfig, hostAwait *wait.HostAwaitility, restconfig *rest.Config, namespace string) *wait.MemberAwaitility {
 	memberClient, err := client.New(restconfig, client.Options{
 		Scheme: schemeWithAllAPIs(t),
 	})
@@ -310,14 +411,17 @@ func getMemberAwaitility(t *testing.T, hostAwait *wait.HostAwaitility, restconfi
 	memberCluster, err := hostAwait.WaitForToolchainClusterWithCondition(t, namespace, toolchainv1alpha1.ConditionReady)
 	require.NoError(t, err)
 	clusterName := memberCluster.Name
-	memberAwait := wait.NewMemberAwaitility(restconfig, memberClient, namespace, clusterName)
+	memberAwait := wait.NewMemberAwaitility(restconfig, memberClient, namespace, clusterName).WithRetryOptions(retryOptions...)
+	memberAwait.ClusterLabels = memberCluster.Labels
+	memberAwait.Timeout = config.Timeouts.Default.Duration
+	memberAwait.RetryInterval = config.Timeouts.RetryInterval.Duration
 
-	memberAwait.WaitForDeploymentToGetReady(t, "member-operator-controller-manager", 1)
+	memberAwait.WaitForDeploymentToGetReady(t, "member-operator-controller-manager", config.Replicas.MemberOperator)
 
 	return memberAwait
 }
 
-func schemeWithAllAPIs(t *testing.T) *runtime.Scheme {
+func schemeWithAllAPIs(t testing.TB) *runtime.Scheme {
 	s := runtime.NewScheme()
 	builder := append(runtime.SchemeBuilder{}, toolchainv1alpha1.AddToScheme,
 		userv1.Install,
@@ -342,10 +446,7 @@ func schemeWithAllAPIs(t *testing.T) *runtime.Scheme {
 }
 
 func IsSecondMemberMode(t *testing.T) bool {
-	secondMemberMode := os.Getenv(wait.SecondMemberModeVar)
-	require.NotEmpty(t, secondMemberMode)
-
-	return secondMemberMode == "true"
+	return GetSuiteConfig(t).IsSecondMemberMode()
 }
 
 // WaitForDevSandboxDashboard waits for the Developer Sandbox Dashboard to be ready.
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/util"
 	dto "github.com/prometheus/client_model/go"
 	"github.com/prometheus/common/expfmt"
 	"github.com/prometheus/common/model"
@@ -47,8 +48,8 @@ func getMetricValue[T any](restConfig *rest.Config, baseURL string, family strin
 	if err != nil {
 		return nil, err
 	}
-	if restConfig.BearerToken != "" {
-		request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", restConfig.BearerToken))
+	if token := util.BearerToken(restConfig); token != "" {
+		request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
 	}
 	resp, err := client.Do(request)
 	if err != nil {
@@ -141,7 +142,7 @@ func GetMetricLabels(restConfig *rest.Config, baseURL string, family string) ([]
 	if err != nil {
 		return nil, err
 	}
-	request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", restConfig.BearerToken))
+	request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", util.BearerToken(restConfig)))
 	resp, err := client.Do(request)
 	if err != nil {
 		return nil, err
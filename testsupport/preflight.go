@@ -0,0 +1,332 @@
+package testsupport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"text/tabwriter"
+	"time"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+)
+
+// the prerequisites of the tests, which are verified when the first test of the package waits for the operators
+const (
+	// PrerequisiteOperators is the readiness of the host and member operators and of the registration service
+	PrerequisiteOperators = "operators"
+	// PrerequisiteAPIProxy is the availability of the route of the API proxy and of its metrics service
+	PrerequisiteAPIProxy = "api-proxy"
+	// PrerequisiteMetricsRoutes is the availability of the routes to the metrics of the operators and of the registration service
+	PrerequisiteMetricsRoutes = "metrics-routes"
+	// PrerequisiteWebhook is the deployment of the member operator webhook in the first member (and only in this one)
+	PrerequisiteWebhook = "webhook"
+	// PrerequisiteAutoscalingBuffer is the deployment of the autoscaling buffer in the members
+	PrerequisiteAutoscalingBuffer = "autoscaling-buffer"
+	// PrerequisiteTiers is the update of the base NSTemplateTier and of the default UserTier by the host operator
+	PrerequisiteTiers = "tiers"
+	// PrerequisiteToolchainStatus is the readiness of the ToolchainStatus
+	PrerequisiteToolchainStatus = "toolchain-status"
+)
+
+// PreflightReport contains the status of the prerequisites of the tests, and the environment in which they were verified
+type PreflightReport struct {
+	Prerequisites []PrerequisiteResult   `json:"prerequisites"`
+	Environment   EnvironmentFingerprint `json:"environment"`
+}
+
+// PrerequisiteResult is the status of a prerequisite
+type PrerequisiteResult struct {
+	Name   string `json:"name"`
+	Passed bool   `json:"passed"`
+	// Reason explains why the prerequisite failed
+	Reason   string `json:"reason,omitempty"`
+	Duration string `json:"duration"`
+}
+
+// EnvironmentFingerprint contains the versions of the operators and of the clusters, and the configuration of the toolchain
+type EnvironmentFingerprint struct {
+	// ClusterVersions contains the versions of the host and member clusters, by name
+	ClusterVersions     map[string]string                      `json:"clusterVersions,omitempty"`
+	HostOperator        ComponentVersion                       `json:"hostOperator"`
+	RegistrationService ComponentVersion                       `json:"registrationService"`
+	MemberOperators     map[string]ComponentVersion            `json:"memberOperators,omitempty"`
+	ToolchainConfig     *toolchainv1alpha1.ToolchainConfigSpec `json:"toolchainConfig,omitempty"`
+	// Errors contains the errors which occurred while collecting the fingerprint
+	Errors []string `json:"errors,omitempty"`
+}
+
+// ComponentVersion is the version of an operator or of the registration service
+type ComponentVersion struct {
+	Version        string `json:"version,omitempty"`
+	Revision       string `json:"revision,omitempty"`
+	BuildTimestamp string `json:"buildTimestamp,omitempty"`
+}
+
+var preflight = struct {
+	sync.Mutex
+	report *PreflightReport
+}{}
+
+// checkPrerequisite verifies the prerequisite with the given check, executed with a preflightT so that its failure doesn't fail
+// the test which verifies the prerequisites, nor abort the verification of the other prerequisites. The check is not executed
+// if one of the prerequisites it depends on failed.
+// It returns true if the prerequisite passed.
+func checkPrerequisite(t *testing.T, name string, dependsOn []string, check func(t testing.TB)) bool {
+	result := PrerequisiteResult{Name: name}
+	for _, dependency := range dependsOn {
+		if passed, found := prerequisiteStatus(dependency); found && !passed {
+			result.Reason = fmt.Sprintf("the '%s' prerequisite failed", dependency)
+			recordPrerequisite(result)
+			return false
+		}
+	}
+	start := time.Now()
+	pt := &preflightT{T: t, name: name}
+	done := make(chan struct{})
+	// executed in its own goroutine, since FailNow stops the goroutine of the check
+	go func() {
+		defer close(done)
+		check(pt)
+	}()
+	<-done
+	result.Duration = time.Since(start).Round(time.Millisecond).String()
+	result.Passed = !pt.Failed()
+	if !result.Passed {
+		result.Reason = fmt.Sprintf("the verification failed, see the logs of '%s'", t.Name())
+	}
+	recordPrerequisite(result)
+	return result.Passed
+}
+
+// preflightT replaces the testing.T of a preflight check: the failures of the check are logged and recorded instead of failing
+// the test, so that a failed prerequisite only skips the tests which require it (see RequirePrerequisites)
+type preflightT struct {
+	*testing.T
+	name   string
+	failed atomic.Bool
+}
+
+func (p *preflightT) Fail() {
+	p.failed.Store(true)
+}
+
+func (p *preflightT) Failed() bool {
+	return p.failed.Load()
+}
+
+// FailNow stops the check, as testing.T does
+func (p *preflightT) FailNow() {
+	p.Fail()
+	runtime.Goexit()
+}
+
+func (p *preflightT) Error(args ...any) {
+	p.Helper()
+	p.Logf("preflight-%s failed: %s", p.name, fmt.Sprint(args...))
+	p.Fail()
+}
+
+func (p *preflightT) Errorf(format string, args ...any) {
+	p.Helper()
+	p.Logf("preflight-%s failed: %s", p.name, fmt.Sprintf(format, args...))
+	p.Fail()
+}
+
+func (p *preflightT) Fatal(args ...any) {
+	p.Helper()
+	p.Error(args...)
+	p.FailNow()
+}
+
+func (p *preflightT) Fatalf(format string, args ...any) {
+	p.Helper()
+	p.Errorf(format, args...)
+	p.FailNow()
+}
+
+// SkipNow stops the check, which is considered as failed since the prerequisite couldn't be verified
+func (p *preflightT) SkipNow() {
+	p.FailNow()
+}
+
+func (p *preflightT) Skip(args ...any) {
+	p.Helper()
+	p.Logf("preflight-%s skipped: %s", p.name, fmt.Sprint(args...))
+	p.SkipNow()
+}
+
+func (p *preflightT) Skipf(format string, args ...any) {
+	p.Helper()
+	p.Logf("preflight-%s skipped: %s", p.name, fmt.Sprintf(format, args...))
+	p.SkipNow()
+}
+
+func recordPrerequisite(result PrerequisiteResult) {
+	preflight.Lock()
+	defer preflight.Unlock()
+	if preflight.report == nil {
+		preflight.report = &PreflightReport{}
+	}
+	preflight.report.Prerequisites = append(preflight.report.Prerequisites, result)
+}
+
+// prerequisiteStatus returns true if the prerequisite passed, and false if it failed or wasn't verified (in which case found is false)
+func prerequisiteStatus(name string) (passed, found bool) {
+	preflight.Lock()
+	defer preflight.Unlock()
+	if preflight.report == nil {
+		return false, false
+	}
+	for _, p := range preflight.report.Prerequisites {
+		if p.Name == name {
+			return p.Passed, true
+		}
+	}
+	return false, false
+}
+
+// failedPrerequisites returns the names of the prerequisites which failed
+func failedPrerequisites() []string {
+	preflight.Lock()
+	defer preflight.Unlock()
+	if preflight.report == nil {
+		return nil
+	}
+	var failed []string
+	for _, p := range preflight.report.Prerequisites {
+		if !p.Passed {
+			failed = append(failed, p.Name)
+		}
+	}
+	return failed
+}
+
+// RequirePrerequisites skips the test if one of the given prerequisites failed. The prerequisites which were not verified
+// (eg, when the test package only waits for the operators) are ignored.
+func RequirePrerequisites(t *testing.T, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		if passed, found := prerequisiteStatus(name); found && !passed {
+			t.Skipf("skipping the test because the '%s' prerequisite failed (see the preflight report)", name)
+		}
+	}
+}
+
+// recordEnvironment collects the fingerprint of the environment in which the prerequisites were verified. The errors are
+// recorded in the fingerprint, since it's only informative.
+func recordEnvironment(awaitilities wait.Awaitilities) {
+	env := EnvironmentFingerprint{
+		ClusterVersions: map[string]string{},
+		MemberOperators: map[string]ComponentVersion{},
+	}
+	recordError := func(format string, args ...any) {
+		env.Errors = append(env.Errors, fmt.Sprintf(format, args...))
+	}
+	clusters := []*wait.Awaitility{awaitilities.Host().Awaitility}
+	for _, m := range awaitilities.AllMembers() {
+		clusters = append(clusters, m.Awaitility)
+	}
+	for _, a := range clusters {
+		discoveryClient, err := discovery.NewDiscoveryClientForConfig(a.RestConfig)
+		if err != nil {
+			recordError("unable to get the version of the %s cluster: %s", a.ClusterName, err)
+			continue
+		}
+		version, err := discoveryClient.ServerVersion()
+		if err != nil {
+			recordError("unable to get the version of the %s cluster: %s", a.ClusterName, err)
+			continue
+		}
+		env.ClusterVersions[a.ClusterName] = version.GitVersion
+	}
+
+	host := awaitilities.Host()
+	status := &toolchainv1alpha1.ToolchainStatus{}
+	if err := host.Client.Get(context.TODO(), types.NamespacedName{Namespace: host.Namespace, Name: "toolchain-status"}, status); err != nil {
+		recordError("unable to get the ToolchainStatus: %s", err)
+	} else {
+		if status.Status.HostOperator != nil {
+			env.HostOperator = ComponentVersion{
+				Version:        status.Status.HostOperator.Version,
+				Revision:       status.Status.HostOperator.Revision,
+				BuildTimestamp: status.Status.HostOperator.BuildTimestamp,
+			}
+		}
+		if status.Status.RegistrationService != nil {
+			env.RegistrationService = ComponentVersion{
+				Revision:       status.Status.RegistrationService.Health.Revision,
+				BuildTimestamp: status.Status.RegistrationService.Health.BuildTime,
+			}
+		}
+		for _, member := range status.Status.Members {
+			if member.MemberStatus.MemberOperator != nil {
+				env.MemberOperators[member.ClusterName] = ComponentVersion{
+					Version:        member.MemberStatus.MemberOperator.Version,
+					Revision:       member.MemberStatus.MemberOperator.Revision,
+					BuildTimestamp: member.MemberStatus.MemberOperator.BuildTimestamp,
+				}
+			}
+		}
+	}
+
+	config := &toolchainv1alpha1.ToolchainConfig{}
+	if err := host.Client.Get(context.TODO(), types.NamespacedName{Namespace: host.Namespace, Name: "config"}, config); err != nil {
+		recordError("unable to get the ToolchainConfig: %s", err)
+	} else {
+		env.ToolchainConfig = &config.Spec
+	}
+
+	preflight.Lock()
+	defer preflight.Unlock()
+	if preflight.report == nil {
+		preflight.report = &PreflightReport{}
+	}
+	preflight.report.Environment = env
+}
+
+// logPreflightReport logs the status of the prerequisites and the versions of the operators
+func logPreflightReport(t *testing.T) {
+	preflight.Lock()
+	defer preflight.Unlock()
+	if preflight.report == nil {
+		return
+	}
+	out := &strings.Builder{}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PREREQUISITE\tSTATUS\tDURATION\tREASON")
+	for _, p := range preflight.report.Prerequisites {
+		status := "passed"
+		if !p.Passed {
+			status = "FAILED"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", p.Name, status, p.Duration, p.Reason)
+	}
+	_ = w.Flush()
+	env := preflight.report.Environment
+	fmt.Fprintf(out, "clusters: %v\nhost operator: %+v\nregistration service: %+v\nmember operators: %+v\n",
+		env.ClusterVersions, env.HostOperator, env.RegistrationService, env.MemberOperators)
+	t.Logf("preflight report:\n%s", out)
+}
+
+// writePreflightReport writes the preflight report of the suite in `$ARTIFACT_DIR/preflight-<suite>.json`, if the prerequisites
+// were verified
+func writePreflightReport(suite string) error {
+	preflight.Lock()
+	defer preflight.Unlock()
+	if preflight.report == nil {
+		return nil
+	}
+	content, err := json.MarshalIndent(preflight.report, "", "  ")
+	if err != nil {
+		return err
+	}
+	return wait.WriteArtifact(fmt.Sprintf("preflight-%s.json", suite), content)
+}
@@ -0,0 +1,209 @@
+package testsupport
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func resetPreflightReport(t *testing.T) {
+	preflight.Lock()
+	defer preflight.Unlock()
+	preflight.report = nil
+	t.Cleanup(func() {
+		preflight.Lock()
+		defer preflight.Unlock()
+		preflight.report = nil
+	})
+}
+
+func TestCheckPrerequisite(t *testing.T) {
+	t.Run("passed", func(t *testing.T) {
+		// given
+		resetPreflightReport(t)
+		executed := false
+
+		// when
+		passed := checkPrerequisite(t, PrerequisiteOperators, nil, func(t testing.TB) {
+			executed = true
+		})
+
+		// then
+		assert.True(t, passed)
+		assert.True(t, executed)
+		passed, found := prerequisiteStatus(PrerequisiteOperators)
+		assert.True(t, found)
+		assert.True(t, passed)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		for name, check := range map[string]func(t testing.TB){
+			"error": func(t testing.TB) {
+				assert.Fail(t, "the route is not available")
+			},
+			"fatal": func(t testing.TB) {
+				require.Fail(t, "the route is not available")
+				assert.Fail(t, "the check should have been stopped")
+			},
+			"skipped": func(t testing.TB) {
+				t.Skip("the route can't be verified")
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				// given
+				resetPreflightReport(t)
+
+				// when
+				passed := checkPrerequisite(t, PrerequisiteMetricsRoutes, nil, check)
+
+				// then
+				assert.False(t, passed)
+				// the failure of the check doesn't fail the test
+				assert.False(t, t.Failed())
+				require.Len(t, preflight.report.Prerequisites, 1)
+				assert.Equal(t, PrerequisiteMetricsRoutes, preflight.report.Prerequisites[0].Name)
+				assert.False(t, preflight.report.Prerequisites[0].Passed)
+				assert.Equal(t, "the verification failed, see the logs of '"+t.Name()+"'", preflight.report.Prerequisites[0].Reason)
+			})
+		}
+	})
+
+	t.Run("dependency failed", func(t *testing.T) {
+		// given
+		resetPreflightReport(t)
+		recordPrerequisite(PrerequisiteResult{Name: PrerequisiteOperators, Reason: "the verification failed"})
+		executed := false
+
+		// when
+		passed := checkPrerequisite(t, PrerequisiteWebhook, []string{PrerequisiteOperators}, func(t testing.TB) {
+			executed = true
+		})
+
+		// then
+		assert.False(t, passed)
+		assert.False(t, executed)
+		require.Len(t, preflight.report.Prerequisites, 2)
+		assert.Equal(t, PrerequisiteResult{Name: PrerequisiteWebhook, Reason: "the 'operators' prerequisite failed"}, preflight.report.Prerequisites[1])
+	})
+
+	t.Run("dependency not verified", func(t *testing.T) {
+		// given
+		resetPreflightReport(t)
+
+		// when
+		passed := checkPrerequisite(t, PrerequisiteWebhook, []string{PrerequisiteOperators}, func(t testing.TB) {})
+
+		// then
+		assert.True(t, passed)
+	})
+}
+
+func TestRequirePrerequisites(t *testing.T) {
+	// given
+	resetPreflightReport(t)
+	recordPrerequisite(PrerequisiteResult{Name: PrerequisiteOperators, Passed: true})
+	recordPrerequisite(PrerequisiteResult{Name: PrerequisiteWebhook, Reason: "the verification failed"})
+
+	for name, tc := range map[string]struct {
+		prerequisites []string
+		skipped       bool
+	}{
+		"passed": {
+			prerequisites: []string{PrerequisiteOperators},
+			skipped:       false,
+		},
+		"not verified": {
+			prerequisites: []string{PrerequisiteOperators, PrerequisiteMetricsRoutes},
+			skipped:       false,
+		},
+		"failed": {
+			prerequisites: []string{PrerequisiteOperators, PrerequisiteWebhook},
+			skipped:       true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			var skipped bool
+			t.Run("test", func(t *testing.T) {
+				defer func() {
+					skipped = t.Skipped()
+				}()
+				RequirePrerequisites(t, tc.prerequisites...)
+			})
+
+			// then
+			assert.Equal(t, tc.skipped, skipped)
+		})
+	}
+}
+
+func TestFailedPrerequisites(t *testing.T) {
+	t.Run("not verified", func(t *testing.T) {
+		// given
+		resetPreflightReport(t)
+
+		// when
+		failed := failedPrerequisites()
+
+		// then
+		assert.Empty(t, failed)
+	})
+
+	t.Run("verified", func(t *testing.T) {
+		// given
+		resetPreflightReport(t)
+		recordPrerequisite(PrerequisiteResult{Name: PrerequisiteOperators, Passed: true})
+		recordPrerequisite(PrerequisiteResult{Name: PrerequisiteWebhook, Reason: "the verification failed"})
+		recordPrerequisite(PrerequisiteResult{Name: PrerequisiteTiers, Reason: "the verification failed"})
+
+		// when
+		failed := failedPrerequisites()
+
+		// then
+		assert.Equal(t, []string{PrerequisiteWebhook, PrerequisiteTiers}, failed)
+	})
+}
+
+func TestWritePreflightReport(t *testing.T) {
+	t.Run("not verified", func(t *testing.T) {
+		// given
+		resetPreflightReport(t)
+		dir := t.TempDir()
+		t.Setenv(wait.ArtifactDirVar, dir)
+
+		// when
+		err := writePreflightReport("e2e")
+
+		// then
+		require.NoError(t, err)
+		assert.NoFileExists(t, filepath.Join(dir, "preflight-e2e.json"))
+	})
+
+	t.Run("verified", func(t *testing.T) {
+		// given
+		resetPreflightReport(t)
+		dir := t.TempDir()
+		t.Setenv(wait.ArtifactDirVar, dir)
+		recordPrerequisite(PrerequisiteResult{Name: PrerequisiteOperators, Passed: true, Duration: "1s"})
+		recordPrerequisite(PrerequisiteResult{Name: PrerequisiteWebhook, Reason: "the verification failed", Duration: "2s"})
+
+		// when
+		err := writePreflightReport("e2e")
+
+		// then
+		require.NoError(t, err)
+		content, err := os.ReadFile(filepath.Join(dir, "preflight-e2e.json"))
+		require.NoError(t, err)
+		report := PreflightReport{}
+		require.NoError(t, json.Unmarshal(content, &report))
+		assert.Equal(t, []PrerequisiteResult{
+			{Name: PrerequisiteOperators, Passed: true, Duration: "1s"},
+			{Name: PrerequisiteWebhook, Reason: "the verification failed", Duration: "2s"},
+		}, report.Prerequisites)
+	})
+}
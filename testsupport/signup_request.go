@@ -1,6 +1,7 @@
 package testsupport
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -20,6 +21,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var httpClient = HTTPClient
@@ -263,8 +265,11 @@ func (r *SignupRequest) Execute(t *testing.T) *SignupResult {
 			"cannot specify a target cluster for new signup requests while automatic approval is enabled")
 	}
 
+	// the UserSignup is created by the registration service, so the labels of the run and test are set afterwards
 	if r.manuallyApprove || r.targetCluster != nil || (r.verificationRequired != states.VerificationRequired(userSignup)) {
 		doUpdate := func(instance *toolchainv1alpha1.UserSignup) {
+			wait.StampRun(t, instance)
+
 			// We set the VerificationRequired state first, because if manuallyApprove is also set then it will
 			// reset the VerificationRequired state to false.
 			if r.verificationRequired != states.VerificationRequired(instance) {
@@ -282,6 +287,13 @@ func (r *SignupRequest) Execute(t *testing.T) *SignupResult {
 		userSignup, err = wait.For(t, hostAwait.Awaitility, &toolchainv1alpha1.UserSignup{}).
 			Update(userSignup.Name, hostAwait.Namespace, doUpdate)
 		require.NoError(t, err)
+	} else {
+		// no other update to make, so the labels are set with a single patch
+		stamped := userSignup.DeepCopy()
+		wait.StampRun(t, stamped)
+		err = hostAwait.Client.Patch(context.TODO(), stamped, client.MergeFrom(userSignup))
+		require.NoError(t, err)
+		userSignup = stamped
 	}
 
 	t.Logf("user signup created: %+v", userSignup)
@@ -319,6 +331,9 @@ func (r *SignupRequest) Execute(t *testing.T) *SignupResult {
 		r.mur = mur
 	}
 
+	// include the UserSignup and its related resources in the diagnostics if the test fails
+	hostAwait.Track(t, r.userSignup)
+
 	// We also need to ensure that the UserSignup is deleted at the end of the test (if the test itself doesn't delete it)
 	// and if cleanup hasn't been disabled
 	if !r.cleanupDisabled {
@@ -1,10 +1,12 @@
 package space
 
 import (
+	"context"
 	"testing"
 
 	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/cleanup"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/util"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
 
@@ -66,6 +68,16 @@ func CreateSpaceRequest(t *testing.T, awaitilities wait.Awaitilities, memberName
 	require.NotEmpty(t, spaceRequest)
 	err = memberAwait.CreateWithCleanup(t, spaceRequest)
 	require.NoError(t, err)
+	// the subspace is in the host cluster, so its deletion can't be verified with the client of the member
+	hostAwait := awaitilities.Host()
+	cleanup.VerifyDeleted(t, spaceRequest, func(ctx context.Context) (bool, error) {
+		subSpaces := &toolchainv1alpha1.SpaceList{}
+		err := hostAwait.Client.List(ctx, subSpaces, client.InNamespace(hostAwait.Namespace), client.MatchingLabels{
+			toolchainv1alpha1.SpaceRequestLabelKey:          spaceRequest.Name,
+			toolchainv1alpha1.SpaceRequestNamespaceLabelKey: spaceRequest.Namespace,
+		})
+		return err == nil && len(subSpaces.Items) == 0, err
+	})
 
 	return spaceRequest, parentSpace
 }
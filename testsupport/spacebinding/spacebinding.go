@@ -40,6 +40,7 @@ func CreateSpaceBindingStr(t *testing.T, hostAwait *wait.HostAwaitility, murName
 // CreateSpaceBindingWithoutCleanup creates SpaceBinding resource for the given MUR & Space with the given space role; and doesn't mark the resource to be ready for cleanup
 func CreateSpaceBindingWithoutCleanup(t *testing.T, hostAwait *wait.HostAwaitility, mur *toolchainv1alpha1.MasterUserRecord, space *toolchainv1alpha1.Space, spaceRole string) *toolchainv1alpha1.SpaceBinding {
 	spaceBinding := NewSpaceBinding(mur, space, spaceRole)
+	wait.StampRun(t, spaceBinding)
 	err := hostAwait.Client.Create(context.TODO(), spaceBinding)
 	require.NoError(t, err)
 
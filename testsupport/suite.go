@@ -0,0 +1,90 @@
+package testsupport
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/cleanup"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+)
+
+// RunSuite runs the tests of the package, then deletes the objects retained by the failed tests (see cleanup.SweepRetained),
+// checks that the tests didn't leak any object (see wait.FindLeaks), reports the waits done by the tests (see wait.ReportWaits),
+// writes the preflight report of the prerequisites (see RequirePrerequisites), fails the suite if a prerequisite failed (even if
+// the tests which depend on it were skipped) and deletes the service accounts of the scoped
+// e2e identity (see ScopedE2EIdentity).
+// It is meant to be called from the `TestMain` function of the test packages:
+//
+//	func TestMain(m *testing.M) {
+//		os.Exit(testsupport.RunSuite(m, "e2e"))
+//	}
+func RunSuite(m *testing.M, suite string) int {
+	return runSuite(m, suite, true)
+}
+
+// RunMigrationSuite runs the tests of a migration package like RunSuite, but without checking the leaked objects, since
+// the setup of the migration keeps the objects it creates for the verification
+func RunMigrationSuite(m *testing.M, suite string) int {
+	return runSuite(m, suite, false)
+}
+
+func runSuite(m *testing.M, suite string, leakCheck bool) int {
+	code := m.Run()
+	if err := cleanup.SweepRetained(); err != nil {
+		fmt.Printf("unable to delete the objects retained by the failed tests: %s\n", err)
+		code = 1
+	}
+	if leakCheck {
+		if err := checkLeaks(suite); err != nil {
+			fmt.Println(err)
+			code = 1
+		}
+	}
+	wait.ReportWaits(suite)
+	if err := writePreflightReport(suite); err != nil {
+		fmt.Printf("unable to write the preflight report: %s\n", err)
+	}
+	if failed := failedPrerequisites(); len(failed) > 0 {
+		fmt.Printf("the following prerequisites of the '%s' suite failed, see the preflight report: %s\n", suite, strings.Join(failed, ", "))
+		code = 1
+	}
+	if err := deleteScopedIdentities(); err != nil {
+		fmt.Printf("unable to delete the scoped e2e identities: %s\n", err)
+		code = 1
+	}
+	return code
+}
+
+// checkLeaks compares the objects of the clusters with the ones which existed before the tests, and returns an error if more
+// objects than the threshold in the LEAK_THRESHOLD env var were leaked
+func checkLeaks(suite string) error {
+	// no test waited for the operators
+	if initSnapshot == nil {
+		return nil
+	}
+	threshold := 0
+	if value := os.Getenv(wait.LeakThresholdVar); value != "" {
+		var err error
+		if threshold, err = strconv.Atoi(value); err != nil {
+			return fmt.Errorf("invalid value of the %s env var: %w", wait.LeakThresholdVar, err)
+		}
+	}
+	awaitilities := wait.NewAwaitilities(initHostAwait, initMemberAwaits...)
+	leaks, err := wait.FindLeaks(awaitilities, initSnapshot, initHostAwait.Timeout)
+	if err != nil {
+		return fmt.Errorf("unable to check the objects leaked by the '%s' suite: %w", suite, err)
+	}
+	if len(leaks) == 0 {
+		return nil
+	}
+	fmt.Printf("objects leaked by the '%s' suite:\n", suite)
+	wait.PrintLeaks(os.Stdout, leaks)
+	if len(leaks) > threshold {
+		return fmt.Errorf("the '%s' suite leaked %d objects, which is more than the threshold of %d (set in the %s env var): "+
+			"make sure that the tests listed above delete the objects they create", suite, len(leaks), threshold, wait.LeakThresholdVar)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package testsupport
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// the tags of the tests, which are the capabilities of the environment that the tests need (see Requires)
+const (
+	// TagSecondMember is the deployment of a second member cluster
+	TagSecondMember = "second-member"
+	// TagWebhook is the deployment of the member operator webhook
+	TagWebhook = "webhook"
+	// TagAppStudioTier is the availability of the `appstudio` NSTemplateTier
+	TagAppStudioTier = "appstudio-tier"
+	// TagPhoneVerification is the configuration of the Twilio account used by the registration service to verify the phone numbers
+	TagPhoneVerification = "phone-verification"
+)
+
+// capabilityCheck returns the reason why the environment doesn't have a capability, or an empty string if it has it
+type capabilityCheck func(awaitilities wait.Awaitilities) (string, error)
+
+var capabilityChecks = map[string]capabilityCheck{
+	TagSecondMember: func(awaitilities wait.Awaitilities) (string, error) {
+		if len(awaitilities.AllMembers()) < 2 {
+			return fmt.Sprintf("the suite runs with a single member cluster (see %s)", wait.SecondMemberModeVar), nil
+		}
+		return "", nil
+	},
+	TagWebhook: func(awaitilities wait.Awaitilities) (string, error) {
+		if len(awaitilities.Members(wait.WithCapability(wait.WebhookCapability))) == 0 {
+			return "the member operator webhook is not deployed in any member cluster", nil
+		}
+		return "", nil
+	},
+	TagAppStudioTier: func(awaitilities wait.Awaitilities) (string, error) {
+		hostAwait := awaitilities.Host()
+		tier := &toolchainv1alpha1.NSTemplateTier{}
+		if err := hostAwait.Client.Get(context.TODO(), types.NamespacedName{Namespace: hostAwait.Namespace, Name: "appstudio"}, tier); err != nil {
+			if errors.IsNotFound(err) {
+				return "the 'appstudio' NSTemplateTier doesn't exist", nil
+			}
+			return "", err
+		}
+		return "", nil
+	},
+	TagPhoneVerification: func(awaitilities wait.Awaitilities) (string, error) {
+		hostAwait := awaitilities.Host()
+		config := &toolchainv1alpha1.ToolchainConfig{}
+		if err := hostAwait.Client.Get(context.TODO(), types.NamespacedName{Namespace: hostAwait.Namespace, Name: "config"}, config); err != nil {
+			if errors.IsNotFound(err) {
+				return "the ToolchainConfig doesn't exist", nil
+			}
+			return "", err
+		}
+		secret := config.Spec.Host.RegistrationService.Verification.Secret
+		if secret.Ref == nil || *secret.Ref == "" || secret.TwilioAccountSID == nil || *secret.TwilioAccountSID == "" {
+			return "the Twilio account is not configured in the ToolchainConfig", nil
+		}
+		return "", nil
+	},
+}
+
+// knownTags returns the tags which can be used with Requires, sorted by name
+func knownTags() []string {
+	return slices.Sorted(maps.Keys(capabilityChecks))
+}
+
+// Requires skips the test if it's filtered out by its tags (see TagsConfig), or if the environment doesn't have the capabilities
+// of the given tags. It must be called after WaitForOperators or WaitForDeployments, eg:
+//
+//	awaitilities := WaitForDeployments(t)
+//	Requires(t, TagSecondMember, TagWebhook)
+func Requires(t *testing.T, tags ...string) {
+	t.Helper()
+	for _, tag := range tags {
+		_, found := capabilityChecks[tag]
+		require.Truef(t, found, "unknown tag '%s', the known tags are: %s", tag, strings.Join(knownTags(), ", "))
+	}
+	if reason := GetSuiteConfig(t).Tags.skipReason(tags); reason != "" {
+		t.Skipf("skipping the test with the tags %v because %s", tags, reason)
+	}
+
+	require.NotNil(t, initHostAwait, "Requires must be called after WaitForOperators or WaitForDeployments")
+	awaitilities := wait.NewAwaitilities(initHostAwait, initMemberAwaits...)
+	for _, tag := range tags {
+		reason, err := capabilityChecks[tag](awaitilities)
+		require.NoError(t, err, "unable to verify the '%s' capability of the environment", tag)
+		if reason != "" {
+			t.Skipf("skipping the test because the environment doesn't have the '%s' capability: %s", tag, reason)
+		}
+	}
+}
+
+// skipReason returns the reason why the tests with the given tags are filtered out, or an empty string if they are not
+func (c TagsConfig) skipReason(tags []string) string {
+	for _, tag := range tags {
+		if slices.Contains(c.Exclude, tag) {
+			return fmt.Sprintf("the '%s' tag is excluded", tag)
+		}
+	}
+	if len(c.Include) == 0 {
+		return ""
+	}
+	for _, tag := range tags {
+		if slices.Contains(c.Include, tag) {
+			return ""
+		}
+	}
+	return fmt.Sprintf("none of its tags is included (%s)", strings.Join(c.Include, ", "))
+}
@@ -0,0 +1,136 @@
+package testsupport
+
+import (
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/wait"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSkipReason(t *testing.T) {
+	for name, tc := range map[string]struct {
+		config TagsConfig
+		tags   []string
+		reason string
+	}{
+		"no filter": {
+			tags: []string{TagWebhook},
+		},
+		"excluded": {
+			config: TagsConfig{Exclude: []string{TagPhoneVerification}},
+			tags:   []string{TagWebhook, TagPhoneVerification},
+			reason: "the 'phone-verification' tag is excluded",
+		},
+		"included": {
+			config: TagsConfig{Include: []string{TagWebhook}},
+			tags:   []string{TagSecondMember, TagWebhook},
+		},
+		"not included": {
+			config: TagsConfig{Include: []string{TagWebhook, TagAppStudioTier}},
+			tags:   []string{TagSecondMember},
+			reason: "none of its tags is included (webhook, appstudio-tier)",
+		},
+		"included and excluded": {
+			config: TagsConfig{Include: []string{TagWebhook}, Exclude: []string{TagWebhook}},
+			tags:   []string{TagWebhook},
+			reason: "the 'webhook' tag is excluded",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			reason := tc.config.skipReason(tc.tags)
+
+			// then
+			assert.Equal(t, tc.reason, reason)
+		})
+	}
+}
+
+func TestCapabilityChecks(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, toolchainv1alpha1.AddToScheme(s))
+	newAwaitilities := func(objects []client.Object, members ...*wait.MemberAwaitility) wait.Awaitilities {
+		cl := fake.NewClientBuilder().WithScheme(s).WithObjects(objects...).Build()
+		return wait.NewAwaitilities(&wait.HostAwaitility{Awaitility: &wait.Awaitility{Client: cl, Namespace: "host"}}, members...)
+	}
+	newMember := func(name string, capabilities ...wait.Capability) *wait.MemberAwaitility {
+		member := &wait.MemberAwaitility{Awaitility: &wait.Awaitility{ClusterName: name}}
+		member.AddCapabilities(capabilities...)
+		return member
+	}
+	twilioConfig := &toolchainv1alpha1.ToolchainConfig{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "host", Name: "config"},
+	}
+	secretRef, twilioAccountSID := "host-operator-secret", "twilio.account.sid"
+	twilioConfig.Spec.Host.RegistrationService.Verification.Secret.Ref = &secretRef
+	twilioConfig.Spec.Host.RegistrationService.Verification.Secret.TwilioAccountSID = &twilioAccountSID
+
+	for name, tc := range map[string]struct {
+		tag          string
+		awaitilities wait.Awaitilities
+		reason       string
+	}{
+		"second member": {
+			tag:          TagSecondMember,
+			awaitilities: newAwaitilities(nil, newMember("member1"), newMember("member2")),
+		},
+		"single member": {
+			tag:          TagSecondMember,
+			awaitilities: newAwaitilities(nil, newMember("member1"), nil),
+			reason:       "the suite runs with a single member cluster (see SECOND_MEMBER_MODE)",
+		},
+		"webhook": {
+			tag:          TagWebhook,
+			awaitilities: newAwaitilities(nil, newMember("member1", wait.WebhookCapability), newMember("member2")),
+		},
+		"no webhook": {
+			tag:          TagWebhook,
+			awaitilities: newAwaitilities(nil, newMember("member1", wait.AutoscalingBufferCapability)),
+			reason:       "the member operator webhook is not deployed in any member cluster",
+		},
+		"appstudio tier": {
+			tag: TagAppStudioTier,
+			awaitilities: newAwaitilities([]client.Object{
+				&toolchainv1alpha1.NSTemplateTier{ObjectMeta: metav1.ObjectMeta{Namespace: "host", Name: "appstudio"}},
+			}),
+		},
+		"no appstudio tier": {
+			tag: TagAppStudioTier,
+			awaitilities: newAwaitilities([]client.Object{
+				&toolchainv1alpha1.NSTemplateTier{ObjectMeta: metav1.ObjectMeta{Namespace: "host", Name: "base"}},
+			}),
+			reason: "the 'appstudio' NSTemplateTier doesn't exist",
+		},
+		"phone verification": {
+			tag:          TagPhoneVerification,
+			awaitilities: newAwaitilities([]client.Object{twilioConfig}),
+		},
+		"no twilio account": {
+			tag: TagPhoneVerification,
+			awaitilities: newAwaitilities([]client.Object{
+				&toolchainv1alpha1.ToolchainConfig{ObjectMeta: metav1.ObjectMeta{Namespace: "host", Name: "config"}},
+			}),
+			reason: "the Twilio account is not configured in the ToolchainConfig",
+		},
+		"no toolchain config": {
+			tag:          TagPhoneVerification,
+			awaitilities: newAwaitilities(nil),
+			reason:       "the ToolchainConfig doesn't exist",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			reason, err := capabilityChecks[tc.tag](tc.awaitilities)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.reason, reason)
+		})
+	}
+}
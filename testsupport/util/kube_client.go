@@ -27,6 +27,17 @@ func BuildKubernetesRESTConfig(apiConfig api.Config) (*rest.Config, error) {
 	return clientcmd.NewDefaultClientConfig(apiConfig, &configOverrides).ClientConfig()
 }
 
+// BearerToken returns the token of the given config, which is read from the token file when it's set, since the token may have
+// been refreshed since the config was created
+func BearerToken(restConfig *rest.Config) string {
+	if restConfig.BearerTokenFile != "" {
+		if token, err := os.ReadFile(restConfig.BearerTokenFile); err == nil {
+			return string(token)
+		}
+	}
+	return restConfig.BearerToken
+}
+
 // NewKubeClientFromSecret reads the kubeconfig from a given secret and create a kube rest client from it. You can supply functions to initialize
 // the scheme with which the client will be built.
 func NewKubeClientFromSecret(t *testing.T, cl client.Client, secretName, secretNamespace string, schemeAdders ...func(*runtime.Scheme) error) (client.Client, *corev1.Secret) {
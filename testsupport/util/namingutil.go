@@ -10,7 +10,7 @@ var notAllowedChars = regexp.MustCompile("[^-a-z0-9]")
 
 // NewObjectNamePrefix creates a namePrefix to be used as .ObjectMeta.GenerateName field.
 // The name prefix is based on the name of the test using this function.
-func NewObjectNamePrefix(t *testing.T) string {
+func NewObjectNamePrefix(t testing.TB) string {
 	namePrefix := strings.ToLower(t.Name())
 	// Remove all invalid characters
 	namePrefix = notAllowedChars.ReplaceAllString(namePrefix, "")
@@ -1,11 +1,22 @@
 package wait
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
 
+// NewAwaitilities returns the awaitilities of the host and of the given members. The nil members are ignored.
 func NewAwaitilities(hostAwait *HostAwaitility, memberAwaitilities ...*MemberAwaitility) Awaitilities {
+	members := make([]*MemberAwaitility, 0, len(memberAwaitilities))
+	for _, m := range memberAwaitilities {
+		if m != nil {
+			members = append(members, m)
+		}
+	}
 	return Awaitilities{
 		hostAwaitility:     hostAwait,
-		memberAwaitilities: memberAwaitilities,
+		memberAwaitilities: members,
 	}
 }
 
@@ -22,7 +33,12 @@ func (a Awaitilities) Member1() *MemberAwaitility {
 	return a.memberAwaitilities[0]
 }
 
+// Member2 returns the awaitility of the second member, or nil if there's a single member (ie, when the second member mode
+// is disabled). The tests which need it should use RequireMember instead, which fails them with a clear message.
 func (a Awaitilities) Member2() *MemberAwaitility {
+	if len(a.memberAwaitilities) < 2 {
+		return nil
+	}
 	return a.memberAwaitilities[1]
 }
 
@@ -38,3 +54,102 @@ func (a Awaitilities) Member(name string) (*MemberAwaitility, error) {
 func (a Awaitilities) AllMembers() []*MemberAwaitility {
 	return a.memberAwaitilities
 }
+
+// MemberSelector selects member clusters (see Members)
+type MemberSelector interface {
+	Matches(member *MemberAwaitility) bool
+	String() string
+}
+
+// WithClusterName selects the member cluster with the given name
+func WithClusterName(name string) MemberSelector {
+	return clusterNameSelector(name)
+}
+
+type clusterNameSelector string
+
+func (s clusterNameSelector) Matches(member *MemberAwaitility) bool {
+	return member.ClusterName == string(s)
+}
+
+func (s clusterNameSelector) String() string {
+	return fmt.Sprintf("name=%s", string(s))
+}
+
+// WithClusterLabel selects the member clusters whose ToolchainCluster has the given label
+func WithClusterLabel(key, value string) MemberSelector {
+	return clusterLabelSelector{key: key, value: value}
+}
+
+type clusterLabelSelector struct {
+	key, value string
+}
+
+func (s clusterLabelSelector) Matches(member *MemberAwaitility) bool {
+	actual, found := member.ClusterLabels[s.key]
+	return found && actual == s.value
+}
+
+func (s clusterLabelSelector) String() string {
+	return fmt.Sprintf("label %s=%s", s.key, s.value)
+}
+
+// WithCapability selects the member clusters which have the given capability (eg, the webhook is deployed)
+func WithCapability(capability Capability) MemberSelector {
+	return capabilitySelector(capability)
+}
+
+type capabilitySelector Capability
+
+func (s capabilitySelector) Matches(member *MemberAwaitility) bool {
+	return member.HasCapability(Capability(s))
+}
+
+func (s capabilitySelector) String() string {
+	return fmt.Sprintf("capability %s", string(s))
+}
+
+// Members returns the member clusters matching all the given selectors, in the order in which they were configured
+func (a Awaitilities) Members(selectors ...MemberSelector) []*MemberAwaitility {
+	var members []*MemberAwaitility
+members:
+	for _, m := range a.memberAwaitilities {
+		for _, s := range selectors {
+			if !s.Matches(m) {
+				continue members
+			}
+		}
+		members = append(members, m)
+	}
+	return members
+}
+
+// RequireMember returns the first member cluster matching all the given selectors, or fails the test if there is none
+func (a Awaitilities) RequireMember(t testing.TB, selectors ...MemberSelector) *MemberAwaitility {
+	members := a.Members(selectors...)
+	if len(members) == 0 {
+		t.Fatalf("no member cluster matching %s", describeSelectors(selectors))
+	}
+	return members[0]
+}
+
+// ForEachMember runs the given function as a subtest named after the member cluster, for each member cluster matching all
+// the given selectors
+func (a Awaitilities) ForEachMember(t *testing.T, f func(t *testing.T, member *MemberAwaitility), selectors ...MemberSelector) {
+	for _, m := range a.Members(selectors...) {
+		t.Run(m.ClusterName, func(t *testing.T) {
+			f(t, m)
+		})
+	}
+}
+
+func describeSelectors(selectors []MemberSelector) string {
+	if len(selectors) == 0 {
+		return "any selector"
+	}
+	descriptions := make([]string, len(selectors))
+	for i, s := range selectors {
+		descriptions[i] = s.String()
+	}
+	return strings.Join(descriptions, ", ")
+}
@@ -0,0 +1,72 @@
+package wait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMembers(t *testing.T) {
+	// given
+	member1 := NewMemberAwaitility(nil, nil, "member-1", "member1")
+	member1.ClusterLabels = map[string]string{"region": "east"}
+	member1.AddCapabilities(WebhookCapability, AutoscalingBufferCapability)
+	member2 := NewMemberAwaitility(nil, nil, "member-2", "member2")
+	member2.ClusterLabels = map[string]string{"region": "west"}
+	member2.AddCapabilities(AutoscalingBufferCapability)
+	member3 := NewMemberAwaitility(nil, nil, "member-3", "member3").WithRetryOptions()
+	member3.ClusterLabels = map[string]string{"region": "east"}
+	awaitilities := NewAwaitilities(nil, member1, member2, nil, member3)
+
+	t.Run("all members", func(t *testing.T) {
+		assert.Equal(t, []*MemberAwaitility{member1, member2, member3}, awaitilities.AllMembers())
+		assert.Equal(t, []*MemberAwaitility{member1, member2, member3}, awaitilities.Members())
+	})
+
+	t.Run("second member", func(t *testing.T) {
+		assert.Equal(t, member2, awaitilities.Member2())
+		assert.Nil(t, NewAwaitilities(nil, member1, nil).Member2())
+	})
+
+	t.Run("by name", func(t *testing.T) {
+		assert.Equal(t, []*MemberAwaitility{member2}, awaitilities.Members(WithClusterName("member2")))
+		assert.Empty(t, awaitilities.Members(WithClusterName("unknown")))
+	})
+
+	t.Run("by label", func(t *testing.T) {
+		assert.Equal(t, []*MemberAwaitility{member1, member3}, awaitilities.Members(WithClusterLabel("region", "east")))
+		assert.Empty(t, awaitilities.Members(WithClusterLabel("zone", "east")))
+	})
+
+	t.Run("by capability", func(t *testing.T) {
+		assert.Equal(t, []*MemberAwaitility{member1}, awaitilities.Members(WithCapability(WebhookCapability)))
+		assert.Equal(t, []*MemberAwaitility{member1, member2}, awaitilities.Members(WithCapability(AutoscalingBufferCapability)))
+	})
+
+	t.Run("by several selectors", func(t *testing.T) {
+		assert.Equal(t, []*MemberAwaitility{member1}, awaitilities.Members(WithClusterLabel("region", "east"), WithCapability(AutoscalingBufferCapability)))
+		assert.Empty(t, awaitilities.Members(WithClusterLabel("region", "west"), WithCapability(WebhookCapability)))
+	})
+
+	t.Run("require member", func(t *testing.T) {
+		assert.Equal(t, member2, awaitilities.RequireMember(t, WithClusterLabel("region", "west")))
+	})
+
+	t.Run("for each member", func(t *testing.T) {
+		// when
+		var visited []string
+		awaitilities.ForEachMember(t, func(t *testing.T, member *MemberAwaitility) {
+			visited = append(visited, member.ClusterName)
+		}, WithCapability(AutoscalingBufferCapability))
+
+		// then
+		require.Equal(t, []string{"member1", "member2"}, visited)
+	})
+}
+
+func TestDescribeSelectors(t *testing.T) {
+	assert.Equal(t, "any selector", describeSelectors(nil))
+	assert.Equal(t, "name=member1, label region=east, capability webhook",
+		describeSelectors([]MemberSelector{WithClusterName("member1"), WithClusterLabel("region", "east"), WithCapability(WebhookCapability)}))
+}
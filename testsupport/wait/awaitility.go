@@ -20,6 +20,7 @@ import (
 	"github.com/codeready-toolchain/toolchain-common/pkg/test/assertions"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/cleanup"
 	"github.com/codeready-toolchain/toolchain-e2e/testsupport/metrics"
+	testutil "github.com/codeready-toolchain/toolchain-e2e/testsupport/util"
 
 	routev1 "github.com/openshift/api/route/v1"
 	"github.com/redhat-cop/operator-utils/pkg/util"
@@ -30,9 +31,10 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/rest"
 	"k8s.io/kubectl/pkg/util/podutils"
 	k8smetrics "k8s.io/metrics/pkg/apis/metrics/v1beta1"
@@ -40,14 +42,31 @@ import (
 )
 
 const (
-	DefaultRetryInterval             = time.Millisecond * 100 // make it short because a "retry interval" is waited before the first test
-	DefaultTimeout                   = time.Second * 120
-	MemberNsVar                      = "MEMBER_NS"
-	MemberNsVar2                     = "MEMBER_NS_2"
-	SecondMemberModeVar              = "SECOND_MEMBER_MODE"
+	DefaultRetryInterval = time.Millisecond * 100 // make it short because a "retry interval" is waited before the first test
+	DefaultTimeout       = time.Second * 120
+	MemberNsVar          = "MEMBER_NS"
+	MemberNsVar2         = "MEMBER_NS_2"
+	SecondMemberModeVar  = "SECOND_MEMBER_MODE"
+	// MemberNameVar is the name of the env var with the name of the ToolchainCluster of the member, which pairs the discovered
+	// member with its kubeconfig and context
+	MemberNameVar  = "MEMBER_NAME"
+	MemberNameVar2 = "MEMBER_NAME_2"
+	// MemberDiscoveryVar is the name of the env var which, when set to `true`, makes the member clusters be discovered from the
+	// ToolchainClusters of the host instead of being configured with the MEMBER_NS, MEMBER_NS_2, etc. env vars
+	MemberDiscoveryVar               = "MEMBER_DISCOVERY"
 	HostNsVar                        = "HOST_NS"
 	RegistrationServiceVar           = "REGISTRATION_SERVICE_NS"
+	WaitStrategyVar                  = "WAIT_STRATEGY"
 	ToolchainClusterConditionTimeout = 180 * time.Second
+
+	// the env vars with the paths of the kubeconfig files and the names of the contexts of the host and member clusters,
+	// when they are not the default ones
+	HostKubeconfigVar     = "HOST_KUBECONFIG"
+	HostKubecontextVar    = "HOST_KUBECONTEXT"
+	MemberKubeconfigVar   = "MEMBER_KUBECONFIG"
+	MemberKubecontextVar  = "MEMBER_KUBECONTEXT"
+	MemberKubeconfigVar2  = "MEMBER_KUBECONFIG_2"
+	MemberKubecontextVar2 = "MEMBER_KUBECONTEXT_2"
 )
 
 type Awaitility struct {
@@ -58,6 +77,8 @@ type Awaitility struct {
 	RetryInterval           time.Duration
 	Timeout                 time.Duration
 	MetricsURL              string
+	backoff                 *Backoff
+	kindBackoffs            map[string]Backoff
 	baselineValues          map[string]float64
 	baselineHistogramValues map[string]map[float64]uint64
 }
@@ -86,13 +107,15 @@ type RetryOption interface {
 	apply(*Awaitility)
 }
 
-// RetryInterval an option to configure the RetryInterval
+// RetryInterval an option to configure the RetryInterval. It replaces the Backoff applied so far, so that the retries happen
+// at this fixed interval, but not the KindBackoff options which keep applying until they are overridden by another KindBackoff.
 type RetryInterval time.Duration
 
 var _ RetryOption = RetryInterval(0)
 
 func (o RetryInterval) apply(a *Awaitility) {
 	a.RetryInterval = time.Duration(o)
+	a.backoff = nil
 }
 
 // TimeoutOption an option to configure the Timeout
@@ -105,7 +128,7 @@ func (o TimeoutOption) apply(a *Awaitility) {
 }
 
 // WaitForMetricDelta waits for the metric value to reach the adjusted value. The adjusted value is the delta value combined with the baseline value.
-func (a *Awaitility) WaitForMetricDelta(t *testing.T, family string, delta float64, labels ...string) {
+func (a *Awaitility) WaitForMetricDelta(t testing.TB, family string, delta float64, labels ...string) {
 	// The delta is relative to the starting value, eg. If there are 3 usersignups when a test is started and we are waiting
 	// for 2 more usersignups to be created (delta is +2) then the actual metric value (adjustedValue) we're waiting for is 5
 	key := a.baselineKey(t, family, labels...)
@@ -115,14 +138,14 @@ func (a *Awaitility) WaitForMetricDelta(t *testing.T, family string, delta float
 
 // WaitForHistogramInfBucketDelta waits for the histogram +Inf bucket value to reach the adjusted value.
 // The adjusted value is the delta value combined with the baseline value of the +Inf bucket
-func (a *Awaitility) WaitForHistogramInfBucketDelta(t *testing.T, family string, delta uint64, labels ...string) {
+func (a *Awaitility) WaitForHistogramInfBucketDelta(t testing.TB, family string, delta uint64, labels ...string) {
 	key := a.baselineKey(t, family, labels...)
 
 	baseline := a.baselineHistogramValues[key][math.Inf(1)]
 	expectedValue := baseline + delta
 	t.Logf("waiting for the +Inf bucket in histogram '%s{%v}' to reach '%v'", family, labels, expectedValue)
 	var actualValues map[float64]uint64
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.poll(t, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		actualValues = a.GetHistogramValues(t, family, labels...)
 		return actualValues[math.Inf(1)] == expectedValue, nil
 	})
@@ -131,7 +154,7 @@ func (a *Awaitility) WaitForHistogramInfBucketDelta(t *testing.T, family string,
 }
 
 // WaitForMetricBaseline waits for the metric value to reach the baseline value back (to be used during the cleanup)
-func (a *Awaitility) WaitForMetricBaseline(t *testing.T, family string, labels ...string) {
+func (a *Awaitility) WaitForMetricBaseline(t testing.TB, family string, labels ...string) {
 	t.Log("waiting until host metrics reached their baseline again...")
 	key := a.baselineKey(t, family, labels...)
 	a.WaitUntiltMetricHasValue(t, family, a.baselineValues[key], labels...)
@@ -140,7 +163,7 @@ func (a *Awaitility) WaitForMetricBaseline(t *testing.T, family string, labels .
 // generates a key to retain the baseline metric value, by joining the metric name and its labels.
 // Note: there are probably more sophisticated ways to combine the name and the labels, but for now
 // this simple concatenation should be enough to make the keys unique
-func (a *Awaitility) baselineKey(t *testing.T, name string, labelAndValues ...string) string {
+func (a *Awaitility) baselineKey(t testing.TB, name string, labelAndValues ...string) string {
 	if len(labelAndValues)%2 != 0 {
 		t.Fatal("`labelAndValues` must be pairs of labels and values")
 	}
@@ -148,13 +171,13 @@ func (a *Awaitility) baselineKey(t *testing.T, name string, labelAndValues ...st
 }
 
 // WaitForService waits until there's a service with the given name in the current namespace
-func (a *Awaitility) WaitForService(t *testing.T, name string) (corev1.Service, error) {
+func (a *Awaitility) WaitForService(t testing.TB, name string) (corev1.Service, error) {
 	t.Logf("waiting for Service '%s' in namespace '%s'", name, a.Namespace)
 	var metricsSvc *corev1.Service
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &corev1.Service{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		metricsSvc = &corev1.Service{}
 		// retrieve the metrics service from the namespace
-		err = a.Client.Get(context.TODO(),
+		err = a.Client.Get(ctx,
 			types.NamespacedName{
 				Namespace: a.Namespace,
 				Name:      name,
@@ -173,11 +196,11 @@ func (a *Awaitility) WaitForService(t *testing.T, name string) (corev1.Service,
 
 // WaitForToolchainClusterWithCondition waits until there is a ToolchainCluster representing a operator of the given type
 // and running in the given expected namespace. It also checks if the CR has the ClusterConditionType
-func (a *Awaitility) WaitForToolchainClusterWithCondition(t *testing.T, namespace string, cdtype toolchainv1alpha1.ConditionType) (toolchainv1alpha1.ToolchainCluster, error) {
+func (a *Awaitility) WaitForToolchainClusterWithCondition(t testing.TB, namespace string, cdtype toolchainv1alpha1.ConditionType) (toolchainv1alpha1.ToolchainCluster, error) {
 	t.Logf("waiting for ToolchainCluster in namespace '%s'", namespace)
 
 	var c toolchainv1alpha1.ToolchainCluster
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.poll(t, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		var ready bool
 		if c, ready, err = a.GetToolchainCluster(t, namespace, cdtype); ready {
 			return true, nil
@@ -189,7 +212,7 @@ func (a *Awaitility) WaitForToolchainClusterWithCondition(t *testing.T, namespac
 
 // GetToolchainCluster retrieves and returns a ToolchainCluster representing a operator of the given type
 // and running in the given expected namespace. It also checks if the CR has the ClusterConditionType
-func (a *Awaitility) GetToolchainCluster(t *testing.T, namespace string, cdtype toolchainv1alpha1.ConditionType) (toolchainv1alpha1.ToolchainCluster, bool, error) {
+func (a *Awaitility) GetToolchainCluster(t testing.TB, namespace string, cdtype toolchainv1alpha1.ConditionType) (toolchainv1alpha1.ToolchainCluster, bool, error) {
 	clusters := &toolchainv1alpha1.ToolchainClusterList{}
 	if err := a.Client.List(context.TODO(), clusters, client.InNamespace(a.Namespace)); err != nil {
 		return toolchainv1alpha1.ToolchainCluster{}, false, err
@@ -209,7 +232,7 @@ func (a *Awaitility) GetToolchainCluster(t *testing.T, namespace string, cdtype
 // SetupRouteForService if needed, creates a route for the given service (with the same namespace/name)
 // It waits until the route is available (or returns an error) by first checking the resource status
 // and then making a call to the given endpoint
-func (a *Awaitility) SetupRouteForService(t *testing.T, serviceName string, path string, port *routev1.RoutePort, tlsConfig *routev1.TLSConfig) (routev1.Route, error) {
+func (a *Awaitility) SetupRouteForService(t testing.TB, serviceName string, path string, port *routev1.RoutePort, tlsConfig *routev1.TLSConfig) (routev1.Route, error) {
 	t.Logf("setting up route for service '%s' with endpoint '%s'", serviceName, path)
 	service, err := a.WaitForService(t, serviceName)
 	if err != nil {
@@ -246,12 +269,12 @@ func (a *Awaitility) SetupRouteForService(t *testing.T, serviceName string, path
 
 // WaitForRouteToBeAvailable waits until the given route is available, ie, it has an Ingress with a host configured
 // and the endpoint is reachable (with a `200 OK` status response)
-func (a *Awaitility) WaitForRouteToBeAvailable(t *testing.T, ns, name, path string) (routev1.Route, error) {
+func (a *Awaitility) WaitForRouteToBeAvailable(t testing.TB, ns, name, path string) (routev1.Route, error) {
 	t.Logf("waiting for route '%s' in namespace '%s'", name, ns)
 	route := routev1.Route{}
 	// retrieve the route for the registration service
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
-		if err = a.Client.Get(context.TODO(),
+	err := a.poll(t, a.Timeout, func(ctx context.Context) (done bool, err error) {
+		if err = a.Client.Get(ctx,
 			types.NamespacedName{
 				Namespace: ns,
 				Name:      name,
@@ -281,7 +304,7 @@ func (a *Awaitility) WaitForRouteToBeAvailable(t *testing.T, ns, name, path stri
 			if err != nil {
 				return false, err
 			}
-			request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", a.RestConfig.BearerToken))
+			request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", testutil.BearerToken(a.RestConfig)))
 		} else {
 			request, err = http.NewRequest("GET", "http://"+route.Status.Ingress[0].Host+path, nil)
 			if err != nil {
@@ -310,7 +333,7 @@ func (a *Awaitility) WaitForRouteToBeAvailable(t *testing.T, ns, name, path stri
 
 // GetMetricValue gets the value of the metric with the given family and label key-value pair
 // fails if the metric with the given labelAndValues does not exist
-func (a *Awaitility) GetMetricValue(t *testing.T, family string, labelAndValues ...string) float64 {
+func (a *Awaitility) GetMetricValue(t testing.TB, family string, labelAndValues ...string) float64 {
 	value, err := metrics.GetMetricValue(a.RestConfig, a.MetricsURL, family, labelAndValues)
 	require.NoError(t, err)
 	return value
@@ -318,7 +341,7 @@ func (a *Awaitility) GetMetricValue(t *testing.T, family string, labelAndValues
 
 // GetHistogramValues gets the value of the histogram with the given family and label key-value pair
 // fails if the histogram with the given labelAndValues does not exist
-func (a *Awaitility) GetHistogramValues(t *testing.T, family string, labelAndValues ...string) map[float64]uint64 {
+func (a *Awaitility) GetHistogramValues(t testing.TB, family string, labelAndValues ...string) map[float64]uint64 {
 	buckets, err := metrics.GetHistogramBuckets(a.RestConfig, a.MetricsURL, family, labelAndValues)
 	require.NoError(t, err)
 	values := make(map[float64]uint64, len(buckets))
@@ -330,7 +353,7 @@ func (a *Awaitility) GetHistogramValues(t *testing.T, family string, labelAndVal
 
 // GetMetricValue gets the value of the metric with the given family and label key-value pair
 // fails if the metric with the given labelAndValues does not exist
-func (a *Awaitility) GetMetricLabels(t *testing.T, metricsURL, family string) []map[string]string {
+func (a *Awaitility) GetMetricLabels(t testing.TB, metricsURL, family string) []map[string]string {
 	t.Logf("getting labels for metric '%s' from '%s'", family, metricsURL)
 	labels, err := metrics.GetMetricLabels(a.RestConfig, metricsURL, family)
 	require.NoError(t, err)
@@ -339,7 +362,7 @@ func (a *Awaitility) GetMetricLabels(t *testing.T, metricsURL, family string) []
 
 // GetMetricValue gets the value of the metric with the given family and label key-value pair
 // return 0 if the metric with the given labelAndValues does not exist
-func (a *Awaitility) GetMetricValueOrZero(t *testing.T, family string, labelAndValues ...string) float64 {
+func (a *Awaitility) GetMetricValueOrZero(t testing.TB, family string, labelAndValues ...string) float64 {
 	if len(labelAndValues)%2 != 0 {
 		t.Fatal("`labelAndValues` must be pairs of labels and values")
 	}
@@ -351,10 +374,10 @@ func (a *Awaitility) GetMetricValueOrZero(t *testing.T, family string, labelAndV
 
 // WaitUntiltMetricHasValue asserts that the exposed metric with the given family
 // and label key-value pair reaches the expected value
-func (a *Awaitility) WaitUntiltMetricHasValue(t *testing.T, family string, expectedValue float64, labels ...string) {
+func (a *Awaitility) WaitUntiltMetricHasValue(t testing.TB, family string, expectedValue float64, labels ...string) {
 	t.Logf("waiting for metric '%s{%v}' to reach '%v'", family, labels, expectedValue)
 	var value float64
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.poll(t, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		value, err = metrics.GetMetricValue(a.RestConfig, a.MetricsURL, family, labels)
 		// if error occurred, ignore and return `false` to keep waiting (may be due to endpoint temporarily unavailable)
 		// unless the expected value is `0`, in which case the metric is bot exposed (value==0 and err!= nil), but it's fine too.
@@ -365,10 +388,10 @@ func (a *Awaitility) WaitUntiltMetricHasValue(t *testing.T, family string, expec
 
 // WaitUntilMetricHasValueOrMore waits until the exposed metric with the given family
 // and label key-value pair has reached the expected value (or more)
-func (a *Awaitility) WaitUntilMetricHasValueOrMore(t *testing.T, family string, expectedValue float64, labels ...string) error {
+func (a *Awaitility) WaitUntilMetricHasValueOrMore(t testing.TB, family string, expectedValue float64, labels ...string) error {
 	t.Logf("waiting for metric '%s{%v}' to reach '%v' or more", family, labels, expectedValue)
 	var value float64
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.poll(t, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		value, err = metrics.GetMetricValue(a.RestConfig, a.MetricsURL, family, labels)
 		// if error occurred, return `false` to keep waiting (may be due to endpoint temporarily unavailable)
 		return value >= expectedValue && err == nil, nil
@@ -381,10 +404,10 @@ func (a *Awaitility) WaitUntilMetricHasValueOrMore(t *testing.T, family string,
 
 // WaitUntilMetricHasValueOrLess waits until the exposed metric with the given family
 // and label key-value pair has reached the expected value (or less)
-func (a *Awaitility) WaitUntilMetricHasValueOrLess(t *testing.T, family string, expectedValue float64, labels ...string) error {
+func (a *Awaitility) WaitUntilMetricHasValueOrLess(t testing.TB, family string, expectedValue float64, labels ...string) error {
 	t.Logf("waiting for metric '%s{%v}' to reach '%v' or less", family, labels, expectedValue)
 	var value float64
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.poll(t, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		value, err = metrics.GetMetricValue(a.RestConfig, a.MetricsURL, family, labels)
 		// if error occurred, return `false` to keep waiting (may be due to endpoint temporarily unavailable)
 		return value <= expectedValue && err == nil, nil
@@ -413,9 +436,9 @@ func (a *Awaitility) DeletePods(criteria ...client.ListOption) error {
 // GetMemoryUsage retrieves the memory usage (in KB) of a given the pod
 func (a *Awaitility) GetMemoryUsage(podname, ns string) (int64, error) {
 	var containerMetrics k8smetrics.ContainerMetrics
-	if err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	if err := a.poll(nil, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		podMetrics := k8smetrics.PodMetrics{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{
+		if err := a.Client.Get(ctx, types.NamespacedName{
 			Namespace: ns,
 			Name:      podname,
 		}, &podMetrics); err != nil && !apierrors.IsNotFound(err) {
@@ -437,7 +460,7 @@ func (a *Awaitility) GetMemoryUsage(podname, ns string) (int64, error) {
 
 // CreateNamespace creates a namespace with the given name and waits until it gets active
 // it also adds a deletion of the namespace at the end of the test
-func (a *Awaitility) CreateNamespace(t *testing.T, name string) {
+func (a *Awaitility) CreateNamespace(t testing.TB, name string) {
 	ns := &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: name,
@@ -452,18 +475,18 @@ func (a *Awaitility) CreateNamespace(t *testing.T, name string) {
 }
 
 // WaitForDeploymentToGetReady waits until the deployment with the given name is ready together with the given number of replicas
-func (a *Awaitility) WaitForDeploymentToGetReady(t *testing.T, name string, replicas int, criteria ...DeploymentCriteria) *appsv1.Deployment {
+func (a *Awaitility) WaitForDeploymentToGetReady(t testing.TB, name string, replicas int, criteria ...DeploymentCriteria) *appsv1.Deployment {
 	t.Logf("waiting until deployment '%s' in namespace '%s' is ready", name, a.Namespace)
 	deployment := &appsv1.Deployment{}
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, 6*a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntil(t, &appsv1.Deployment{}, a.Namespace, 6*a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &appsv1.Deployment{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
 			if apierrors.IsNotFound(err) {
 				return false, nil
 			}
 			return false, err
 		}
-		deploymentConditions := status.GetDeploymentStatusConditions(context.TODO(), a.Client, name, a.Namespace)
+		deploymentConditions := status.GetDeploymentStatusConditions(ctx, a.Client, name, a.Namespace)
 		if err := status.ValidateComponentConditionReady(deploymentConditions...); err != nil {
 			return false, nil // nolint:nilerr
 		}
@@ -471,7 +494,7 @@ func (a *Awaitility) WaitForDeploymentToGetReady(t *testing.T, name string, repl
 			return false, nil
 		}
 		pods := &corev1.PodList{}
-		require.NoError(t, a.Client.List(context.TODO(), pods, client.InNamespace(a.Namespace), client.MatchingLabels(obj.Spec.Selector.MatchLabels)))
+		require.NoError(t, a.Client.List(ctx, pods, client.InNamespace(a.Namespace), client.MatchingLabels(obj.Spec.Selector.MatchLabels)))
 		if len(pods.Items) != replicas {
 			return false, nil
 		}
@@ -508,24 +531,19 @@ func DeploymentHasContainerWithImage(containerName, image string) DeploymentCrit
 	}
 }
 
-// ToolchainClusterWaitCriterion a struct to compare with an expected ToolchainCluster CR
-type ToolchainClusterWaitCriterion struct {
-	Match func(toolchainCluster *toolchainv1alpha1.ToolchainCluster) bool
-}
-
 // WaitForToolchainCluster waits until there is a ToolchainCluster CR available with the given list of criteria
-func (a *Awaitility) WaitForToolchainCluster(t *testing.T, criteria ...ToolchainClusterWaitCriterion) (*toolchainv1alpha1.ToolchainCluster, error) {
+func (a *Awaitility) WaitForToolchainCluster(t testing.TB, criteria ...ToolchainClusterWaitCriterion) (*toolchainv1alpha1.ToolchainCluster, error) {
 	t.Logf("waiting for toolchaincluster in namespace '%s' to match criteria", a.Namespace)
 	var clusters *toolchainv1alpha1.ToolchainClusterList
 	var cl *toolchainv1alpha1.ToolchainCluster
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.ToolchainCluster{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		clusters = &toolchainv1alpha1.ToolchainClusterList{}
-		if err := a.Client.List(context.TODO(), clusters, client.InNamespace(a.Namespace)); err != nil {
+		if err := a.Client.List(ctx, clusters, client.InNamespace(a.Namespace)); err != nil {
 			return false, err
 		}
 		for _, obj := range clusters.Items {
 			cpObj := obj
-			if matchesAllCriteria := matchToolchainClusterWaitCriterion(&cpObj, criteria...); matchesAllCriteria {
+			if matchesAllCriteria := matchWaitCriteria(&cpObj, criteria...); matchesAllCriteria {
 				cl = &cpObj
 				return true, nil
 			}
@@ -535,15 +553,6 @@ func (a *Awaitility) WaitForToolchainCluster(t *testing.T, criteria ...Toolchain
 	return cl, err
 }
 
-func matchToolchainClusterWaitCriterion(actual *toolchainv1alpha1.ToolchainCluster, criteria ...ToolchainClusterWaitCriterion) bool {
-	for _, c := range criteria {
-		if !c.Match(actual) {
-			return false
-		}
-	}
-	return true
-}
-
 // UntilToolchainClusterHasName checks if ToolchainCluster has given name
 func UntilToolchainClusterHasName(expectedName string) ToolchainClusterWaitCriterion {
 	return ToolchainClusterWaitCriterion{
@@ -592,26 +601,30 @@ func UntilToolchainClusterHasNoTenantLabel() ToolchainClusterWaitCriterion {
 }
 
 // CreateWithCleanup creates the given object via client.Client.Create() and schedules the cleanup of the object at the end of the current test
-func (a *Awaitility) CreateWithCleanup(t *testing.T, obj client.Object, opts ...client.CreateOption) error {
+func (a *Awaitility) CreateWithCleanup(t testing.TB, obj client.Object, opts ...client.CreateOption) error {
+	StampRun(t, obj)
 	if err := a.Client.Create(context.TODO(), obj, opts...); err != nil {
 		return err
 	}
 	cleanup.AddCleanTasks(t, a.GetClient(), obj)
+	a.Track(t, obj)
 	return nil
 }
 
-func (a *Awaitility) CreateWithCleanupTimeout(t *testing.T, obj client.Object, timeout time.Duration, opts ...client.CreateOption) error {
+func (a *Awaitility) CreateWithCleanupTimeout(t testing.TB, obj client.Object, timeout time.Duration, opts ...client.CreateOption) error {
+	StampRun(t, obj)
 	if err := a.Client.Create(context.TODO(), obj, opts...); err != nil {
 		return err
 	}
 	cleanup.AddCleanTasksWithTimeout(t, a.GetClient(), timeout, obj)
+	a.Track(t, obj)
 	return nil
 }
 
 // Creates a copy of the object specified using the `from` parameter. The created copy is named using the `to` parameter and is cleaned up
 // after the test. The object can be modified using the optionally supplied modifiers before it is created. The `object` is an "output parameter"
 // that will contain the object as it was created in the cluster.
-func CopyWithCleanup[T client.Object](t *testing.T, a *Awaitility, from, to client.ObjectKey, object T, modifiers ...func(T)) {
+func CopyWithCleanup[T client.Object](t testing.TB, a *Awaitility, from, to client.ObjectKey, object T, modifiers ...func(T)) {
 	t.Helper()
 	require.NoError(t, a.Client.Get(context.TODO(), from, object))
 
@@ -627,8 +640,9 @@ func CopyWithCleanup[T client.Object](t *testing.T, a *Awaitility, from, to clie
 	require.NoError(t, a.CreateWithCleanup(t, object))
 }
 
-// Create creates the given object via client.Client.Create()
-func (a *Awaitility) Create(obj client.Object, opts ...client.CreateOption) error {
+// Create creates the given object via client.Client.Create(), with the labels of the current run and test (see StampRun)
+func (a *Awaitility) Create(t testing.TB, obj client.Object, opts ...client.CreateOption) error {
+	StampRun(t, obj)
 	if err := a.Client.Create(context.TODO(), obj, opts...); err != nil {
 		return err
 	}
@@ -636,11 +650,11 @@ func (a *Awaitility) Create(obj client.Object, opts ...client.CreateOption) erro
 }
 
 // Clean triggers cleanup of all resources that were marked to be cleaned before that
-func (a *Awaitility) Clean(t *testing.T) {
+func (a *Awaitility) Clean(t testing.TB) {
 	cleanup.ExecuteAllCleanTasks(t)
 }
 
-func (a *Awaitility) listAndPrint(t *testing.T, resourceKind, namespace string, list client.ObjectList, additionalOptions ...client.ListOption) {
+func (a *Awaitility) listAndPrint(t testing.TB, resourceKind, namespace string, list client.ObjectList, additionalOptions ...client.ListOption) {
 	t.Logf("%s", a.listAndReturnContent(resourceKind, namespace, list, additionalOptions...))
 }
 
@@ -656,7 +670,7 @@ func (a *Awaitility) listAndReturnContent(resourceKind, namespace string, list c
 	return fmt.Sprintf("\n%s present in the namespace:\n%s\n", resourceKind, string(content))
 }
 
-func (a *Awaitility) GetAndPrint(t *testing.T, resourceKind, namespace, name string, obj client.Object, additionalOptions ...client.GetOption) {
+func (a *Awaitility) GetAndPrint(t testing.TB, resourceKind, namespace, name string, obj client.Object, additionalOptions ...client.GetOption) {
 	t.Logf("%s", a.getAndReturnContent(resourceKind, namespace, name, obj, additionalOptions...))
 }
 
@@ -671,90 +685,230 @@ func (a *Awaitility) getAndReturnContent(resourceKind, namespace, name string, o
 // Waiter is a helper struct for `wait.For()` that provides functions to query the cluster waiting
 // for the results.
 type Waiter[T client.Object] struct {
-	await *Awaitility
-	t     *testing.T
-	gvk   schema.GroupVersionKind
+	await         *Awaitility
+	t             testing.TB
+	gvk           schema.GroupVersionKind
+	namespace     string
+	labelSelector labels.Selector
+	fieldSelector fields.Selector
 }
 
 // FirstThat uses the provided predicates to filter the objects of the type provided to `wait.For()` and
 // repeatedly tries to find the first one that satisfies all the predicates.
 func (w *Waiter[T]) FirstThat(predicates ...assertions.Predicate[client.Object]) (T, error) {
-	w.t.Logf("waiting for objects of GVK '%s' in namespace '%s' to match criteria", w.gvk, w.await.Namespace)
+	w.t.Logf("waiting for objects of GVK '%s' in %s to match criteria", w.gvk, w.scope())
 
 	var returnedObject T
 	// match status of each predicate per object
 	latestResults := map[client.ObjectKey][]bool{}
 
-	err := wait.PollUntilContextTimeout(context.TODO(), w.await.RetryInterval, w.await.Timeout, true, func(ctx context.Context) (done bool, err error) {
-		// because there is no generic way of figuring out the list type for some client.Object type, we need to go
-		// down the low level route and use unstructured to get the list generically and unmarshal and cast the list
-		// items.
-		list := &unstructured.UnstructuredList{}
-		list.SetGroupVersionKind(w.gvk)
-		if err := w.await.Client.List(context.TODO(), list, client.InNamespace(w.await.Namespace)); err != nil {
-			return false, err
-		}
-		for _, obj := range list.Items {
-			object, err := w.cast(&obj)
-			if err != nil {
-				return false, fmt.Errorf("failed to cast the object to GVK %v: %w", w.gvk, err)
+	err := w.waitForList(false, func(objects []T) bool {
+		for _, object := range objects {
+			matches, results := w.matches(object, predicates)
+			latestResults[client.ObjectKeyFromObject(object)] = results
+			if matches {
+				returnedObject = object
+				return true
 			}
+		}
+		return false
+	})
+	if err != nil {
+		w.printListDiffs(fmt.Sprintf("failed to find objects (of GVK '%s') in %s matching the criteria: %s", w.gvk, w.scope(), err), predicates, latestResults)
+	}
+	return returnedObject, err
+}
 
+// AllThat waits until there is at least one object of the type provided to `wait.For()` and all of them satisfy
+// all the predicates. It returns all the objects.
+func (w *Waiter[T]) AllThat(predicates ...assertions.Predicate[client.Object]) ([]T, error) {
+	w.t.Logf("waiting for all the objects of GVK '%s' in %s to match criteria", w.gvk, w.scope())
+
+	var returnedObjects []T
+	latestResults := map[client.ObjectKey][]bool{}
+
+	err := w.waitForList(true, func(objects []T) bool {
+		clear(latestResults)
+		allMatch := true
+		for _, object := range objects {
+			matches, results := w.matches(object, predicates)
+			latestResults[client.ObjectKeyFromObject(object)] = results
+			allMatch = allMatch && matches
+		}
+		returnedObjects = objects
+		return len(objects) > 0 && allMatch
+	})
+	if err != nil {
+		w.printListDiffs(fmt.Sprintf("failed to wait for all the objects (of GVK '%s') in %s to match the criteria: %s", w.gvk, w.scope(), err), predicates, latestResults)
+	}
+	return returnedObjects, err
+}
+
+// CountThat waits until exactly the given number of objects of the type provided to `wait.For()` satisfy all
+// the predicates. It returns the matching objects.
+func (w *Waiter[T]) CountThat(count int, predicates ...assertions.Predicate[client.Object]) ([]T, error) {
+	w.t.Logf("waiting for %d objects of GVK '%s' in %s to match criteria", count, w.gvk, w.scope())
+
+	var matchingObjects []T
+	latestResults := map[client.ObjectKey][]bool{}
+
+	err := w.waitForList(true, func(objects []T) bool {
+		clear(latestResults)
+		matchingObjects = nil
+		for _, object := range objects {
 			matches, results := w.matches(object, predicates)
 			latestResults[client.ObjectKeyFromObject(object)] = results
 			if matches {
-				returnedObject = object
-				return true, nil
+				matchingObjects = append(matchingObjects, object)
 			}
 		}
-		return false, nil
+		return len(matchingObjects) == count
 	})
 	if err != nil {
-		sb := strings.Builder{}
-		sb.WriteString("failed to find objects (of GVK '%s') in namespace '%s' matching the criteria: %s")
-		args := []any{w.gvk, w.await.Namespace, err.Error()}
-		list := &unstructured.UnstructuredList{}
-		list.SetGroupVersionKind(w.gvk)
-		if err := w.await.Client.List(context.TODO(), list, client.InNamespace(w.await.Namespace)); err != nil {
-			sb.WriteString(" and also failed to retrieve the object at all with error: %s")
-			args = append(args, err)
-		} else {
-			sb.WriteString("\nlisting the objects found in cluster with the differences from the expected state for each:")
-			for _, o := range list.Items {
-				obj, _ := w.cast(&o)
-				key := client.ObjectKeyFromObject(obj)
-
-				matches := true
-				objectResults := latestResults[key]
-				for _, res := range objectResults {
-					if !res {
-						matches = false
-						break
-					}
+		w.printListDiffs(fmt.Sprintf("expected %d objects (of GVK '%s') in %s matching the criteria but found %d: %s", count, w.gvk, w.scope(), len(matchingObjects), err), predicates, latestResults)
+	}
+	return matchingObjects, err
+}
+
+// waitForList waits until the objects in the scope of the waiter satisfy the given condition. If the condition also depends on
+// the objects which don't match (eg, to count the matching ones), then it's confirmed with the client when it's satisfied by the
+// objects of the informer cache, since the cache may lag behind the cluster.
+func (w *Waiter[T]) waitForList(confirm bool, condition func([]T) bool) error {
+	watched := w.watched()
+	return w.await.waitUntilWatched(w.t, watched, w.namespace, w.await.Timeout, func(ctx context.Context) (done bool, err error) {
+		reader, cached := w.cachedReader(watched)
+		objects, err := w.list(ctx, reader)
+		if err != nil || !condition(objects) {
+			return false, err
+		}
+		if !confirm || !cached {
+			return true, nil
+		}
+		if objects, err = w.list(ctx, w.await.Client); err != nil {
+			return false, err
+		}
+		return condition(objects), nil
+	})
+}
+
+// list returns the objects in the scope of the waiter
+func (w *Waiter[T]) list(ctx context.Context, reader client.Reader) ([]T, error) {
+	// because there is no generic way of figuring out the list type for some client.Object type, we need to go
+	// down the low level route and use unstructured to get the list generically and unmarshal and cast the list
+	// items.
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(w.gvk)
+	if err := reader.List(ctx, list, w.listOptions()...); err != nil {
+		return nil, err
+	}
+	objects := make([]T, 0, len(list.Items))
+	for _, obj := range list.Items {
+		object, err := w.cast(&obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to cast the object to GVK %v: %w", w.gvk, err)
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
+
+// printListDiffs logs the given message along with the objects currently in the scope of the waiter, and the differences
+// with the predicates that they didn't match during the last evaluation
+func (w *Waiter[T]) printListDiffs(msg string, predicates []assertions.Predicate[client.Object], latestResults map[client.ObjectKey][]bool) {
+	sb := strings.Builder{}
+	sb.WriteString(msg)
+	objects, err := w.list(context.TODO(), w.await.Client)
+	if err != nil {
+		sb.WriteString(" and also failed to retrieve the object at all with error: ")
+		sb.WriteString(err.Error())
+	} else {
+		sb.WriteString("\nlisting the objects found in cluster with the differences from the expected state for each:")
+		for _, obj := range objects {
+			key := client.ObjectKeyFromObject(obj)
+
+			matches := true
+			objectResults := latestResults[key]
+			for _, res := range objectResults {
+				if !res {
+					matches = false
+					break
 				}
+			}
 
-				sb.WriteRune('\n')
+			sb.WriteRune('\n')
+			if matches {
 				sb.WriteString("object ")
 				sb.WriteString(key.String())
-				if matches {
-					sb.WriteString("matches all predicates")
-				} else {
-					sb.WriteString("was found to have the following differences:")
-					for i, res := range objectResults {
-						if !res {
-							sb.WriteRune('\n')
-							sb.WriteString(assertions.Explain(predicates[i], obj.DeepCopyObject().(T)))
-						}
-					}
-				}
+				sb.WriteString(" matches all predicates")
+			} else {
+				sb.WriteString(w.explain(obj, predicates, objectResults))
 			}
 		}
-		w.t.Logf(sb.String(), args...)
 	}
-	return returnedObject, err
+	w.t.Log(sb.String())
+}
+
+// InNamespace returns a copy of the waiter which looks for the objects in the given namespace instead of the namespace of
+// the awaitility
+func (w *Waiter[T]) InNamespace(namespace string) *Waiter[T] {
+	result := *w
+	result.namespace = namespace
+	return &result
+}
+
+// WithLabels returns a copy of the waiter which only considers the objects with the given labels when listing objects
+func (w *Waiter[T]) WithLabels(labelSet map[string]string) *Waiter[T] {
+	return w.WithLabelSelector(labels.SelectorFromSet(labelSet))
+}
+
+// WithLabelSelector returns a copy of the waiter which only considers the objects matching the given label selector when
+// listing objects
+func (w *Waiter[T]) WithLabelSelector(selector labels.Selector) *Waiter[T] {
+	result := *w
+	result.labelSelector = selector
+	return &result
+}
+
+// WithFieldSelector returns a copy of the waiter which only considers the objects matching the given field selector when
+// listing objects. Note that the API server only supports a few fields per kind, eg. `metadata.name`.
+func (w *Waiter[T]) WithFieldSelector(selector fields.Selector) *Waiter[T] {
+	result := *w
+	result.fieldSelector = selector
+	return &result
 }
 
-// WithNameMatching waits for a single object with the provided name in the namespace of the awaitality that additionally
+func (w *Waiter[T]) listOptions() []client.ListOption {
+	var options []client.ListOption
+	if w.namespace != "" {
+		options = append(options, client.InNamespace(w.namespace))
+	}
+	if w.labelSelector != nil {
+		options = append(options, client.MatchingLabelsSelector{Selector: w.labelSelector})
+	}
+	if w.fieldSelector != nil {
+		options = append(options, client.MatchingFieldsSelector{Selector: w.fieldSelector})
+	}
+	return options
+}
+
+// cachedReader returns the reader to find the matching objects with (see Awaitility.cachedReader). The informer caches can't be
+// queried with field selectors because they would need an index for each field.
+func (w *Waiter[T]) cachedReader(watched client.Object) (client.Reader, bool) {
+	if w.fieldSelector != nil {
+		return w.await.Client, false
+	}
+	return w.await.cachedReader(watched, w.namespace)
+}
+
+// scope describes where the waiter looks for objects, for the log messages
+func (w *Waiter[T]) scope() string {
+	if w.namespace == "" {
+		return "the cluster"
+	}
+	return fmt.Sprintf("namespace '%s'", w.namespace)
+}
+
+// WithNameMatching waits for a single object with the provided name in the namespace of the waiter that additionally
 // matches the provided predicate function.
 func (w *Waiter[T]) WithNameMatching(name string, predicate func(T) bool) (T, error) {
 	return w.WithNameThat(name, &customPredicate[T]{
@@ -770,18 +924,20 @@ func (p *customPredicate[T]) Matches(object client.Object) bool {
 	return p.predicate(object.(T))
 }
 
-// WithNameThat waits for a single object with the provided name in the namespace of the awaitality that additionally
+// WithNameThat waits for a single object with the provided name in the namespace of the waiter that additionally
 // matches the provided predicates.
 func (w *Waiter[T]) WithNameThat(name string, predicates ...assertions.Predicate[client.Object]) (T, error) {
-	w.t.Logf("waiting for object of GVK '%s' with name '%s' in namespace '%s' to match additional criteria", w.gvk, name, w.await.Namespace)
+	w.t.Logf("waiting for object of GVK '%s' with name '%s' in %s to match additional criteria", w.gvk, name, w.scope())
 
 	var returnedObject T
 	latestResults := []bool{}
 
-	err := wait.PollUntilContextTimeout(context.TODO(), w.await.RetryInterval, w.await.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	watched := w.watched()
+	err := w.await.waitUntilWatched(w.t, watched, w.namespace, w.await.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &unstructured.Unstructured{}
 		obj.SetGroupVersionKind(w.gvk)
-		if err := w.await.Client.Get(context.TODO(), client.ObjectKey{Name: name, Namespace: w.await.Namespace}, obj); err != nil {
+		reader, _ := w.cachedReader(watched)
+		if err := reader.Get(ctx, client.ObjectKey{Name: name, Namespace: w.namespace}, obj); err != nil {
 			if apierrors.IsNotFound(err) {
 				return false, nil
 			}
@@ -803,11 +959,11 @@ func (w *Waiter[T]) WithNameThat(name string, predicates ...assertions.Predicate
 	})
 	if err != nil {
 		sb := strings.Builder{}
-		sb.WriteString("couldn't match the object (GVK '%s') called '%s' in namespace '%s' with the criteria")
-		args := []any{w.gvk, name, w.await.Namespace}
+		sb.WriteString("couldn't match the object (GVK '%s') called '%s' in %s with the criteria")
+		args := []any{w.gvk, name, w.scope()}
 		obj := &unstructured.Unstructured{}
 		obj.SetGroupVersionKind(w.gvk)
-		if err := w.await.Client.Get(context.TODO(), client.ObjectKey{Name: name, Namespace: w.await.Namespace}, obj); err != nil {
+		if err := w.await.Client.Get(context.TODO(), client.ObjectKey{Name: name, Namespace: w.namespace}, obj); err != nil {
 			sb.WriteString(" and also failed to retrieve the object at all with error: %s")
 			args = append(args, err)
 		} else {
@@ -826,13 +982,15 @@ func (w *Waiter[T]) WithNameThat(name string, predicates ...assertions.Predicate
 	return returnedObject, err
 }
 
-// WithNameDeleted waits for a single object with the provided name in the namespace of the awaitility to get deleted
+// WithNameDeleted waits for a single object with the provided name in the namespace of the waiter to get deleted
 func (w *Waiter[T]) WithNameDeleted(name string) error {
-	w.t.Logf("waiting for object of GVK '%s' with name '%s' in namespace '%s' to be deleted", w.gvk, name, w.await.Namespace)
-	err := wait.PollUntilContextTimeout(context.TODO(), w.await.RetryInterval, w.await.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	w.t.Logf("waiting for object of GVK '%s' with name '%s' in %s to be deleted", w.gvk, name, w.scope())
+	watched := w.watched()
+	err := w.await.waitUntilWatched(w.t, watched, w.namespace, w.await.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &unstructured.Unstructured{}
 		obj.SetGroupVersionKind(w.gvk)
-		if err := w.await.Client.Get(context.TODO(), client.ObjectKey{Name: name, Namespace: w.await.Namespace}, obj); err != nil {
+		// the deletion is checked with the client since the cache may not have received the deletion yet
+		if err := w.await.Client.Get(ctx, client.ObjectKey{Name: name, Namespace: w.namespace}, obj); err != nil {
 			if apierrors.IsNotFound(err) {
 				return true, nil
 			}
@@ -842,11 +1000,11 @@ func (w *Waiter[T]) WithNameDeleted(name string) error {
 	})
 	if err != nil {
 		sb := strings.Builder{}
-		sb.WriteString("failed to wait for the the object (GVK '%s') called '%s' in namespace '%s' to be deleted")
-		args := []any{w.gvk, name, w.await.Namespace}
+		sb.WriteString("failed to wait for the the object (GVK '%s') called '%s' in %s to be deleted")
+		args := []any{w.gvk, name, w.scope()}
 		obj := &unstructured.Unstructured{}
 		obj.SetGroupVersionKind(w.gvk)
-		if err := w.await.Client.Get(context.TODO(), client.ObjectKey{Name: name, Namespace: w.await.Namespace}, obj); err != nil {
+		if err := w.await.Client.Get(context.TODO(), client.ObjectKey{Name: name, Namespace: w.namespace}, obj); err != nil {
 			sb.WriteString(" and also failed to retrieve the object at all with error: %s")
 			args = append(args, err)
 		} else {
@@ -861,6 +1019,95 @@ func (w *Waiter[T]) WithNameDeleted(name string) error {
 	return err
 }
 
+// Consistently checks that all the objects in the scope of the waiter keep matching the provided predicates
+// during the given duration. It returns an error describing the first object found to not match the predicates, if any.
+func (w *Waiter[T]) Consistently(duration time.Duration, predicates ...assertions.Predicate[client.Object]) error {
+	w.t.Logf("checking that the objects of GVK '%s' in %s keep matching the criteria for %s", w.gvk, w.scope(), duration)
+
+	var violation string
+	watched := w.watched()
+	start := time.Now()
+	err := w.await.waitUntilWatched(w.t, watched, w.namespace, duration, func(ctx context.Context) (done bool, err error) {
+		// the objects are checked with the client since the cache may lag behind the cluster
+		objects, err := w.list(ctx, w.await.Client)
+		if err != nil {
+			return false, err
+		}
+		for _, object := range objects {
+			if matches, results := w.matches(object, predicates); !matches {
+				violation = w.explain(object, predicates, results)
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	return w.consistentlyResult(err, violation, start, duration)
+}
+
+// NeverExists checks that the object with the provided name doesn't exist in the namespace of the waiter during
+// the given duration. It returns an error describing the object if it's found.
+func (w *Waiter[T]) NeverExists(name string, duration time.Duration) error {
+	w.t.Logf("checking that the object of GVK '%s' with name '%s' in %s doesn't exist for %s", w.gvk, name, w.scope(), duration)
+
+	var violation string
+	watched := w.watched()
+	start := time.Now()
+	err := w.await.waitUntilWatched(w.t, watched, w.namespace, duration, func(ctx context.Context) (done bool, err error) {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(w.gvk)
+		if err := w.await.Client.Get(ctx, client.ObjectKey{Name: name, Namespace: w.namespace}, obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		content, _ := StringifyObject(obj)
+		violation = fmt.Sprintf("object %s exists in the cluster:\n%s", client.ObjectKeyFromObject(obj), content)
+		return true, nil
+	})
+	return w.consistentlyResult(err, violation, start, duration)
+}
+
+// consistentlyResult returns the outcome of a check which should have lasted for the given duration
+func (w *Waiter[T]) consistentlyResult(err error, violation string, start time.Time, duration time.Duration) error {
+	elapsed := time.Since(start).Round(time.Millisecond)
+	switch {
+	case violation != "":
+		err = fmt.Errorf("the objects of GVK '%s' in %s didn't meet the criteria after %s: %s", w.gvk, w.scope(), elapsed, violation)
+	case errors.Is(err, context.DeadlineExceeded) && elapsed >= duration:
+		// the criteria were met during the whole duration
+		return nil
+	case err != nil:
+		err = fmt.Errorf("the check of the objects of GVK '%s' in %s was interrupted after %s: %w", w.gvk, w.scope(), elapsed, err)
+	default:
+		return nil
+	}
+	w.t.Log(err.Error())
+	return err
+}
+
+// explain returns the differences between the object and each of the predicates that it doesn't match
+func (w *Waiter[T]) explain(obj T, predicates []assertions.Predicate[client.Object], results []bool) string {
+	sb := strings.Builder{}
+	sb.WriteString("object ")
+	sb.WriteString(client.ObjectKeyFromObject(obj).String())
+	sb.WriteString(" was found to have the following differences:")
+	for i, res := range results {
+		if !res {
+			sb.WriteRune('\n')
+			sb.WriteString(assertions.Explain(predicates[i], obj.DeepCopyObject().(T)))
+		}
+	}
+	return sb.String()
+}
+
+// watched returns an empty object of the GVK of the waiter, to subscribe to the changes of the objects of that kind
+func (w *Waiter[T]) watched() client.Object {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(w.gvk)
+	return obj
+}
+
 func (w *Waiter[T]) cast(obj *unstructured.Unstructured) (T, error) {
 	var empty T
 	raw, err := obj.MarshalJSON()
@@ -896,16 +1143,25 @@ func (w *Waiter[T]) matches(obj T, predicates []assertions.Predicate[client.Obje
 //
 // Note that this is a recent addition to the utility functions and therefore is not used much. It could
 // be used to cut down the line count of the new utility functions dramatically though.
-func For[T client.Object](t *testing.T, a *Awaitility, obj T) *Waiter[T] {
+func For[T client.Object](t testing.TB, a *Awaitility, obj T) *Waiter[T] {
 	gvks, _, err := a.Client.Scheme().ObjectKinds(obj)
 	require.NoError(t, err, "failed to get the GVK of object %v", obj)
 
 	require.Len(t, gvks, 1, "multiple versions of a single GK not supported but found multiple for object %v", obj)
 
+	// cluster-scoped kinds, such as User or ClusterResourceQuota, are not looked up in the namespace of the awaitility
+	namespace := a.Namespace
+	namespaced, err := a.Client.IsObjectNamespaced(obj)
+	require.NoError(t, err, "failed to determine if object %v is namespaced", obj)
+	if !namespaced {
+		namespace = ""
+	}
+
 	return &Waiter[T]{
-		await: a,
-		t:     t,
-		gvk:   gvks[0],
+		await:     a,
+		t:         t,
+		gvk:       gvks[0],
+		namespace: namespace,
 	}
 }
 
@@ -914,10 +1170,10 @@ func For[T client.Object](t *testing.T, a *Awaitility, obj T) *Waiter[T] {
 // Returns the updated object
 func (w *Waiter[T]) doUpdate(status bool, objectName, objectNamespace string, modify func(T)) (T, error) {
 	var objectToReturn T
-	err := wait.PollUntilContextTimeout(context.TODO(), w.await.RetryInterval, w.await.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := w.await.poll(w.t, w.await.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &unstructured.Unstructured{}
 		obj.SetGroupVersionKind(w.gvk)
-		if err := w.await.Client.Get(context.TODO(), types.NamespacedName{Namespace: objectNamespace, Name: objectName}, obj); err != nil {
+		if err := w.await.Client.Get(ctx, types.NamespacedName{Namespace: objectNamespace, Name: objectName}, obj); err != nil {
 			return true, err
 		}
 		object, err := w.cast(obj)
@@ -927,13 +1183,13 @@ func (w *Waiter[T]) doUpdate(status bool, objectName, objectNamespace string, mo
 		modify(object)
 		if status {
 			// Update the Status
-			if err := w.await.Client.Status().Update(context.TODO(), object); err != nil {
+			if err := w.await.Client.Status().Update(ctx, object); err != nil {
 				w.t.Logf("error updating '%v' Status '%s': %s. Will retry again...", w.gvk, objectName, err.Error())
 				return false, nil
 			}
 		} else {
 			// Update the Spec
-			if err := w.await.Client.Update(context.TODO(), object); err != nil {
+			if err := w.await.Client.Update(ctx, object); err != nil {
 				w.t.Logf("Error updating '%v' Spec '%s': %s. Will retry again...", w.gvk, objectName, err.Error())
 				return false, nil
 			}
@@ -0,0 +1,404 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// newTestAwaitility returns an awaitility of the `operator` namespace with a fake client which contains the given objects, and
+// which can be intercepted with the given functions
+func newTestAwaitility(t *testing.T, funcs interceptor.Funcs, objects ...client.Object) *Awaitility {
+	s := k8sruntime.NewScheme()
+	require.NoError(t, toolchainv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("Namespace"), meta.RESTScopeRoot)
+	mapper.Add(toolchainv1alpha1.GroupVersion.WithKind("UserSignup"), meta.RESTScopeNamespace)
+	mapper.Add(toolchainv1alpha1.GroupVersion.WithKind("Notification"), meta.RESTScopeNamespace)
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithRESTMapper(mapper).
+		WithObjects(objects...).
+		WithInterceptorFuncs(funcs).
+		Build()
+	return &Awaitility{
+		Client:        cl,
+		ClusterName:   "host",
+		Namespace:     "operator",
+		RetryInterval: time.Millisecond,
+		Timeout:       100 * time.Millisecond,
+	}
+}
+
+func newConfigMap(namespace, name string, labels map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels}}
+}
+
+// hasLabel matches the objects which have the given label
+type hasLabel struct {
+	key, value string
+}
+
+func (p hasLabel) Matches(obj client.Object) bool {
+	return obj.GetLabels()[p.key] == p.value
+}
+
+// failureRecorder records the failures of the assertions instead of failing the test, so that the assertions done by the waits
+// can be verified
+type failureRecorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *failureRecorder) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *failureRecorder) FailNow() {
+	runtime.Goexit()
+}
+
+// recordFailures executes the function with a failureRecorder, and returns the failures of the assertions
+func recordFailures(t *testing.T, f func(t testing.TB)) []string {
+	r := &failureRecorder{TB: t}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f(r)
+	}()
+	<-done
+	return r.failures
+}
+
+var errUnavailable = errors.New("the API server is unavailable")
+
+func failingList(_ context.Context, _ client.WithWatch, _ client.ObjectList, _ ...client.ListOption) error {
+	return errUnavailable
+}
+
+func failingGet(_ context.Context, _ client.WithWatch, _ client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+	return errUnavailable
+}
+
+func TestWaiterConsistently(t *testing.T) {
+	ready := map[string]string{"ready": "true"}
+
+	t.Run("the objects keep matching", func(t *testing.T) {
+		// given
+		a := newTestAwaitility(t, interceptor.Funcs{}, newConfigMap("operator", "first", ready), newConfigMap("operator", "second", ready))
+		start := time.Now()
+
+		// when
+		err := For(t, a, &corev1.ConfigMap{}).Consistently(50*time.Millisecond, hasLabel{key: "ready", value: "true"})
+
+		// then
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	})
+
+	t.Run("an object doesn't match", func(t *testing.T) {
+		// given
+		a := newTestAwaitility(t, interceptor.Funcs{}, newConfigMap("operator", "first", ready), newConfigMap("operator", "second", nil))
+		start := time.Now()
+
+		// when
+		err := For(t, a, &corev1.ConfigMap{}).Consistently(time.Minute, hasLabel{key: "ready", value: "true"})
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "the objects of GVK '/v1, Kind=ConfigMap' in namespace 'operator' didn't meet the criteria")
+		assert.Contains(t, err.Error(), "object operator/second was found to have the following differences")
+		assert.Less(t, time.Since(start), time.Minute)
+	})
+
+	t.Run("the objects can't be listed", func(t *testing.T) {
+		// given
+		a := newTestAwaitility(t, interceptor.Funcs{List: failingList}, newConfigMap("operator", "first", ready))
+
+		// when
+		err := For(t, a, &corev1.ConfigMap{}).Consistently(time.Minute, hasLabel{key: "ready", value: "true"})
+
+		// then
+		require.ErrorIs(t, err, errUnavailable)
+		assert.Contains(t, err.Error(), "the check of the objects of GVK '/v1, Kind=ConfigMap' in namespace 'operator' was interrupted")
+	})
+}
+
+func TestWaiterNeverExists(t *testing.T) {
+	t.Run("the object doesn't exist", func(t *testing.T) {
+		// given
+		a := newTestAwaitility(t, interceptor.Funcs{}, newConfigMap("other", "config", nil))
+		start := time.Now()
+
+		// when
+		err := For(t, a, &corev1.ConfigMap{}).NeverExists("config", 50*time.Millisecond)
+
+		// then
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	})
+
+	t.Run("the object exists", func(t *testing.T) {
+		// given
+		a := newTestAwaitility(t, interceptor.Funcs{}, newConfigMap("operator", "config", nil))
+
+		// when
+		err := For(t, a, &corev1.ConfigMap{}).NeverExists("config", time.Minute)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "object operator/config exists in the cluster")
+	})
+
+	t.Run("the object can't be retrieved", func(t *testing.T) {
+		// given
+		a := newTestAwaitility(t, interceptor.Funcs{Get: failingGet})
+
+		// when
+		err := For(t, a, &corev1.ConfigMap{}).NeverExists("config", time.Minute)
+
+		// then
+		require.ErrorIs(t, err, errUnavailable)
+	})
+}
+
+func TestWaiterAllThat(t *testing.T) {
+	ready := map[string]string{"ready": "true"}
+
+	t.Run("all the objects match", func(t *testing.T) {
+		// given
+		a := newTestAwaitility(t, interceptor.Funcs{}, newConfigMap("operator", "first", ready), newConfigMap("operator", "second", ready),
+			newConfigMap("other", "third", nil))
+
+		// when
+		objects, err := For(t, a, &corev1.ConfigMap{}).AllThat(hasLabel{key: "ready", value: "true"})
+
+		// then
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"first", "second"}, names(objects))
+	})
+
+	t.Run("timeout when an object doesn't match", func(t *testing.T) {
+		// given
+		a := newTestAwaitility(t, interceptor.Funcs{}, newConfigMap("operator", "first", ready), newConfigMap("operator", "second", nil))
+
+		// when
+		_, err := For(t, a, &corev1.ConfigMap{}).AllThat(hasLabel{key: "ready", value: "true"})
+
+		// then
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("timeout when there is no object", func(t *testing.T) {
+		// given
+		a := newTestAwaitility(t, interceptor.Funcs{})
+
+		// when
+		_, err := For(t, a, &corev1.ConfigMap{}).AllThat(hasLabel{key: "ready", value: "true"})
+
+		// then
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("the objects can't be listed", func(t *testing.T) {
+		// given
+		a := newTestAwaitility(t, interceptor.Funcs{List: failingList})
+		a.Timeout = time.Minute
+		start := time.Now()
+
+		// when
+		_, err := For(t, a, &corev1.ConfigMap{}).AllThat(hasLabel{key: "ready", value: "true"})
+
+		// then
+		require.ErrorIs(t, err, errUnavailable)
+		assert.Less(t, time.Since(start), time.Minute)
+	})
+}
+
+func TestWaiterCountThat(t *testing.T) {
+	ready := map[string]string{"ready": "true"}
+
+	t.Run("the number of matching objects is reached", func(t *testing.T) {
+		// given
+		a := newTestAwaitility(t, interceptor.Funcs{}, newConfigMap("operator", "first", ready), newConfigMap("operator", "second", ready),
+			newConfigMap("operator", "third", nil))
+
+		// when
+		objects, err := For(t, a, &corev1.ConfigMap{}).CountThat(2, hasLabel{key: "ready", value: "true"})
+
+		// then
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"first", "second"}, names(objects))
+	})
+
+	t.Run("no matching object", func(t *testing.T) {
+		// given
+		a := newTestAwaitility(t, interceptor.Funcs{}, newConfigMap("operator", "first", nil))
+
+		// when
+		objects, err := For(t, a, &corev1.ConfigMap{}).CountThat(0, hasLabel{key: "ready", value: "true"})
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, objects)
+	})
+
+	t.Run("timeout when there are too many matching objects", func(t *testing.T) {
+		// given
+		a := newTestAwaitility(t, interceptor.Funcs{}, newConfigMap("operator", "first", ready), newConfigMap("operator", "second", ready))
+
+		// when
+		objects, err := For(t, a, &corev1.ConfigMap{}).CountThat(1, hasLabel{key: "ready", value: "true"})
+
+		// then
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Len(t, objects, 2)
+	})
+
+	t.Run("the objects can't be listed", func(t *testing.T) {
+		// given
+		a := newTestAwaitility(t, interceptor.Funcs{List: failingList})
+		a.Timeout = time.Minute
+		start := time.Now()
+
+		// when
+		_, err := For(t, a, &corev1.ConfigMap{}).CountThat(1)
+
+		// then
+		require.ErrorIs(t, err, errUnavailable)
+		assert.Less(t, time.Since(start), time.Minute)
+	})
+}
+
+func TestWaiterScope(t *testing.T) {
+	// given
+	a := newTestAwaitility(t, interceptor.Funcs{},
+		newConfigMap("operator", "first", map[string]string{"app": "host", "tier": "base"}),
+		newConfigMap("operator", "second", map[string]string{"app": "member"}),
+		newConfigMap("other", "third", map[string]string{"app": "host"}),
+		newConfigMap("other", "fourth", map[string]string{"app": "member"}),
+	)
+
+	t.Run("namespace of the awaitility", func(t *testing.T) {
+		// when
+		objects, err := For(t, a, &corev1.ConfigMap{}).CountThat(2)
+
+		// then
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"first", "second"}, names(objects))
+	})
+
+	t.Run("in namespace", func(t *testing.T) {
+		// given
+		waiter := For(t, a, &corev1.ConfigMap{})
+
+		// when
+		objects, err := waiter.InNamespace("other").CountThat(2)
+
+		// then
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"third", "fourth"}, names(objects))
+		// the waiter is not modified
+		assert.Equal(t, "operator", waiter.namespace)
+	})
+
+	t.Run("with labels", func(t *testing.T) {
+		// when
+		objects, err := For(t, a, &corev1.ConfigMap{}).WithLabels(map[string]string{"app": "host"}).CountThat(1)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"first"}, names(objects))
+	})
+
+	t.Run("with label selector", func(t *testing.T) {
+		// given
+		selector, err := labels.Parse("app in (host, member), !tier")
+		require.NoError(t, err)
+
+		// when
+		objects, err := For(t, a, &corev1.ConfigMap{}).InNamespace("other").WithLabelSelector(selector).CountThat(2)
+
+		// then
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"third", "fourth"}, names(objects))
+	})
+
+	t.Run("with field selector", func(t *testing.T) {
+		// given
+		a := newTestAwaitility(t, interceptor.Funcs{})
+		a.Client = fake.NewClientBuilder().
+			WithScheme(a.Client.Scheme()).
+			WithRESTMapper(a.Client.RESTMapper()).
+			WithObjects(newConfigMap("operator", "first", nil), newConfigMap("operator", "second", nil), newConfigMap("other", "first", nil)).
+			// the API server indexes the name of all the objects
+			WithIndex(&corev1.ConfigMap{}, "metadata.name", func(obj client.Object) []string {
+				return []string{obj.GetName()}
+			}).
+			Build()
+
+		// when
+		objects, err := For(t, a, &corev1.ConfigMap{}).WithFieldSelector(fields.OneTermEqualSelector("metadata.name", "first")).CountThat(1)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		assert.Equal(t, "operator", objects[0].Namespace)
+		assert.Equal(t, "first", objects[0].Name)
+	})
+
+	t.Run("timeout when no object is in the scope", func(t *testing.T) {
+		// when
+		_, err := For(t, a, &corev1.ConfigMap{}).InNamespace("unknown").FirstThat()
+
+		// then
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestForClusterScopedKind(t *testing.T) {
+	// given
+	a := newTestAwaitility(t, interceptor.Funcs{},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "operator"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "john-dev", Labels: map[string]string{"owner": "john"}}},
+	)
+
+	// when
+	waiter := For(t, a, &corev1.Namespace{})
+
+	// then
+	assert.Empty(t, waiter.namespace)
+	assert.Equal(t, "the cluster", waiter.scope())
+	ns, err := waiter.WithNameThat("john-dev", hasLabel{key: "owner", value: "john"})
+	require.NoError(t, err)
+	assert.Equal(t, "john-dev", ns.Name)
+	objects, err := waiter.CountThat(2)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"operator", "john-dev"}, names(objects))
+}
+
+func names[T client.Object](objects []T) []string {
+	result := make([]string, 0, len(objects))
+	for _, obj := range objects {
+		result = append(result, obj.GetName())
+	}
+	return result
+}
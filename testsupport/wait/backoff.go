@@ -0,0 +1,87 @@
+package wait
+
+import (
+	"math/rand/v2"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// Backoff an option to make the intervals between the retries grow exponentially instead of being the fixed RetryInterval.
+// Eg, `Backoff{Initial: time.Second, Factor: 2, Cap: 10 * time.Second, Jitter: 0.1}` retries after ~1s, ~2s, ~4s, ~8s, then every ~10s.
+type Backoff struct {
+	// Initial is the interval before the first retry. The RetryInterval is used if it's not set.
+	Initial time.Duration
+	// Factor is the multiplier applied to the interval after each retry. The interval doesn't grow if it's lower than 1.
+	Factor float64
+	// Cap is the maximum interval before jitter. The interval grows without limit if it's not set.
+	Cap time.Duration
+	// Jitter is the maximum fraction of the interval which is randomly added to it, so that the concurrent waits don't hit
+	// the API server at the same time
+	Jitter float64
+}
+
+var _ RetryOption = Backoff{}
+
+func (o Backoff) apply(a *Awaitility) {
+	a.backoff = &o
+}
+
+// KindBackoff an option to configure the Backoff of the waits on the objects of the given kind (eg, `Namespace`), which takes
+// precedence over the Backoff of the Awaitility. It only applies to the waits which know the kind of the objects they are waiting for.
+type KindBackoff struct {
+	Kind    string
+	Backoff Backoff
+}
+
+var _ RetryOption = KindBackoff{}
+
+func (o KindBackoff) apply(a *Awaitility) {
+	// the map is shared with the Awaitility this one was copied from
+	kindBackoffs := make(map[string]Backoff, len(a.kindBackoffs)+1)
+	for kind, b := range a.kindBackoffs {
+		kindBackoffs[kind] = b
+	}
+	kindBackoffs[o.Kind] = o.Backoff
+	a.kindBackoffs = kindBackoffs
+}
+
+// intervals returns a function which returns the interval to wait before each retry of a wait on the objects of the same kind
+// as the given one (which can be nil if the kind is unknown)
+func (a *Awaitility) intervals(watched client.Object) func() time.Duration {
+	b := a.backoff
+	if watched != nil && a.Client != nil && len(a.kindBackoffs) > 0 {
+		if gvk, err := apiutil.GVKForObject(watched, a.Client.Scheme()); err == nil {
+			if kb, ok := a.kindBackoffs[gvk.Kind]; ok {
+				b = &kb
+			}
+		}
+	}
+	if b == nil {
+		return func() time.Duration {
+			return a.RetryInterval
+		}
+	}
+	return b.intervals(a.RetryInterval)
+}
+
+func (b Backoff) intervals(defaultInitial time.Duration) func() time.Duration {
+	interval := b.Initial
+	if interval <= 0 {
+		interval = defaultInitial
+	}
+	return func() time.Duration {
+		current := interval
+		if b.Factor > 1 {
+			interval = time.Duration(float64(interval) * b.Factor)
+		}
+		if b.Cap > 0 {
+			interval = min(interval, b.Cap)
+		}
+		if b.Jitter > 0 {
+			current += time.Duration(rand.Float64() * b.Jitter * float64(current))
+		}
+		return current
+	}
+}
@@ -0,0 +1,65 @@
+package wait
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoffIntervals(t *testing.T) {
+	t.Run("exponential up to the cap", func(t *testing.T) {
+		// given
+		next := Backoff{Initial: time.Second, Factor: 2, Cap: 5 * time.Second}.intervals(DefaultRetryInterval)
+
+		// when
+		intervals := []time.Duration{next(), next(), next(), next(), next()}
+
+		// then
+		assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}, intervals)
+	})
+
+	t.Run("initial interval defaults to the retry interval", func(t *testing.T) {
+		// given
+		next := Backoff{Factor: 0.5}.intervals(DefaultRetryInterval)
+
+		// when
+		intervals := []time.Duration{next(), next()}
+
+		// then
+		assert.Equal(t, []time.Duration{DefaultRetryInterval, DefaultRetryInterval}, intervals)
+	})
+
+	t.Run("with jitter", func(t *testing.T) {
+		// given
+		next := Backoff{Initial: time.Second, Jitter: 0.5}.intervals(DefaultRetryInterval)
+
+		for i := 0; i < 10; i++ {
+			// when
+			interval := next()
+
+			// then
+			assert.GreaterOrEqual(t, interval, time.Second)
+			assert.LessOrEqual(t, interval, 1500*time.Millisecond)
+		}
+	})
+}
+
+func TestRetryOptions(t *testing.T) {
+	// given
+	a := &Awaitility{RetryInterval: DefaultRetryInterval}
+
+	// when
+	withBackoff := a.WithRetryOptions(Backoff{Initial: time.Second}, KindBackoff{Kind: "Namespace", Backoff: Backoff{Initial: 2 * time.Second}})
+	withKindBackoff := withBackoff.WithRetryOptions(KindBackoff{Kind: "UserSignup", Backoff: Backoff{Initial: 3 * time.Second}})
+	withInterval := withKindBackoff.WithRetryOptions(RetryInterval(4 * time.Second))
+
+	// then
+	assert.Equal(t, DefaultRetryInterval, a.intervals(nil)())
+	assert.Nil(t, a.kindBackoffs)
+	assert.Equal(t, time.Second, withBackoff.intervals(nil)())
+	assert.Len(t, withBackoff.kindBackoffs, 1) // not modified by the options of its copies
+	assert.Len(t, withKindBackoff.kindBackoffs, 2)
+	assert.Equal(t, 4*time.Second, withInterval.intervals(nil)())
+	assert.Equal(t, withKindBackoff.kindBackoffs, withInterval.kindBackoffs) // only overridden by a KindBackoff
+}
@@ -0,0 +1,65 @@
+package wait
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// diagnosticsGracePeriod is the time kept before the deadline of the test binary (`go test -timeout`) to print the diagnostics
+// of a wait that didn't succeed, before the test binary panics
+const diagnosticsGracePeriod = 15 * time.Second
+
+// waitContext returns the context of a wait of the given test. The context is cancelled when the timeout is reached, when the test
+// is done or shortly before the deadline of the test binary, whichever comes first, so that the wait returns an error and its
+// diagnostics are printed instead of being interrupted by the panic of the test binary.
+//
+// When the test is already done (ie, the wait is called from a cleanup function), only the timeout and the deadline of the test
+// binary apply.
+func waitContext(t testing.TB, timeout time.Duration) (context.Context, context.CancelFunc) {
+	parent := context.Background()
+	if t != nil {
+		if ctx := t.Context(); ctx.Err() == nil {
+			parent = ctx
+		}
+		// only testing.T has a deadline
+		if tt, ok := t.(interface{ Deadline() (time.Time, bool) }); ok {
+			if deadline, ok := tt.Deadline(); ok {
+				if remaining := time.Until(deadline) - diagnosticsGracePeriod; remaining < timeout {
+					timeout = max(remaining, 0)
+				}
+			}
+		}
+	}
+	return context.WithTimeout(parent, timeout)
+}
+
+// poll polls the condition at the RetryInterval (or following the Backoff) until it's true, the timeout is reached or the test is
+// done (see waitContext)
+func (a *Awaitility) poll(t testing.TB, timeout time.Duration, condition wait.ConditionWithContextFunc) error {
+	m := a.measure(t, nil)
+	ctx, cancel := waitContext(t, timeout)
+	defer cancel()
+	err := pollWithIntervals(ctx, a.intervals(nil), m.counting(condition))
+	m.done(err)
+	return err
+}
+
+// pollWithIntervals polls the condition until it's true or the context is done, waiting for the interval returned by the next
+// function between each evaluation
+func pollWithIntervals(ctx context.Context, next func() time.Duration, condition wait.ConditionWithContextFunc) error {
+	for {
+		if done, err := condition(ctx); err != nil || done {
+			return err
+		}
+		timer := time.NewTimer(next())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package wait
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitContext(t *testing.T) {
+	t.Run("cancelled after the timeout", func(t *testing.T) {
+		// when
+		ctx, cancel := waitContext(t, 10*time.Millisecond)
+		defer cancel()
+
+		// then
+		<-ctx.Done()
+		require.ErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+	})
+
+	t.Run("capped to the deadline of the test binary", func(t *testing.T) {
+		testDeadline, ok := t.Deadline()
+		if !ok {
+			t.Skip("no deadline for the test binary")
+		}
+
+		// when
+		ctx, cancel := waitContext(t, time.Until(testDeadline)+time.Hour)
+		defer cancel()
+
+		// then
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		assert.WithinDuration(t, testDeadline.Add(-diagnosticsGracePeriod), deadline, time.Second)
+	})
+
+	t.Run("cancelled when the test is done", func(t *testing.T) {
+		// given
+		var ctx context.Context
+		t.Run("test", func(t *testing.T) {
+			var cancel context.CancelFunc
+			ctx, cancel = waitContext(t, time.Minute)
+			t.Cleanup(cancel)
+		})
+
+		// then
+		require.ErrorIs(t, ctx.Err(), context.Canceled)
+	})
+
+	t.Run("not cancelled in the cleanup functions", func(t *testing.T) {
+		t.Run("test", func(t *testing.T) {
+			t.Cleanup(func() {
+				// when
+				ctx, cancel := waitContext(t, time.Minute)
+				defer cancel()
+
+				// then
+				assert.NoError(t, ctx.Err())
+			})
+		})
+	})
+
+	t.Run("without test", func(t *testing.T) {
+		// when
+		ctx, cancel := waitContext(nil, time.Minute)
+		defer cancel()
+
+		// then
+		assert.NoError(t, ctx.Err())
+	})
+}
+
+func TestPoll(t *testing.T) {
+	// given
+	a := &Awaitility{RetryInterval: time.Millisecond}
+	count := 0
+
+	// when
+	err := a.poll(t, time.Second, func(ctx context.Context) (bool, error) {
+		count++
+		return count == 3, ctx.Err()
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+}
@@ -0,0 +1,340 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/cleanup"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// ArtifactDirVar is the name of the env var with the directory in which the failure diagnostics of the tests are written.
+// It is set in CI, otherwise the diagnostics are written in a temporary directory
+const ArtifactDirVar = "ARTIFACT_DIR"
+
+// tracking contains the objects touched by each (sub)test, and the tests for which the diagnostics are collected
+var tracking = struct {
+	sync.Mutex
+	objects    map[string][]trackedObject
+	collecting map[string]bool
+}{
+	objects:    map[string][]trackedObject{},
+	collecting: map[string]bool{},
+}
+
+type trackedObject struct {
+	await  *Awaitility
+	object client.Object
+}
+
+// Track records that the given objects are touched by the test, so that they are included in the failure diagnostics of the test
+// (see CollectDiagnosticsOnFailure)
+func (a *Awaitility) Track(t testing.TB, objects ...client.Object) {
+	key := t.Name()
+	tracking.Lock()
+	defer tracking.Unlock()
+	for _, obj := range objects {
+		tracking.objects[key] = append(tracking.objects[key], trackedObject{await: a, object: obj})
+	}
+}
+
+// CollectDiagnosticsOnFailure registers a cleanup function which, if the test failed, writes a diagnostics bundle in
+// `$ARTIFACT_DIR/<test name>/` with:
+// - the logs of the host operator, registration service and member operator pods since now,
+// - the events of the operator namespaces and of the namespaces of the users touched by the test since now,
+// - the objects touched by the test (see Awaitility.Track) and the objects related to them (MasterUserRecord, Space,
+// SpaceBindings, UserAccount, NSTemplateSet),
+// - the ToolchainStatus.
+//
+// The diagnostics of a test include the objects touched by its subtests, and they are not collected again for the subtests
+// of a test which already collects them.
+func CollectDiagnosticsOnFailure(t testing.TB, awaitilities Awaitilities) {
+	key := t.Name()
+	tracking.Lock()
+	defer tracking.Unlock()
+	for collecting := range tracking.collecting {
+		if isSubtestOf(key, collecting) {
+			return
+		}
+	}
+	tracking.collecting[key] = true
+	start := time.Now()
+
+	t.Cleanup(func() {
+		tracking.Lock()
+		var tracked []trackedObject
+		for name, objects := range tracking.objects {
+			if isSubtestOf(name, key) {
+				tracked = append(tracked, objects...)
+				delete(tracking.objects, name)
+			}
+		}
+		delete(tracking.collecting, key)
+		tracking.Unlock()
+		if !t.Failed() {
+			return
+		}
+		c := &diagnosticsCollector{
+			t:            t,
+			awaitilities: awaitilities,
+			since:        start,
+			dir:          filepath.Join(artifactDir(), sanitizeFileName(t.Name())),
+		}
+		c.collect(tracked)
+		t.Logf("failure diagnostics written in %s", c.dir)
+		// the objects retained by the failed test(s) are not needed anymore
+		cleanup.ReleaseRetained(key)
+	})
+}
+
+// isSubtestOf returns true if the test with the given name is the given parent test or one of its (nested) subtests
+func isSubtestOf(name, parent string) bool {
+	return name == parent || strings.HasPrefix(name, parent+"/")
+}
+
+var configuredArtifactDir atomic.Value
+
+// SetArtifactDir sets the directory in which the diagnostics and the reports are written, instead of the one in the ARTIFACT_DIR
+// env var. It has no effect if the given directory is empty.
+func SetArtifactDir(dir string) {
+	if dir != "" {
+		configuredArtifactDir.Store(dir)
+	}
+}
+
+func artifactDir() string {
+	if dir, ok := configuredArtifactDir.Load().(string); ok {
+		return dir
+	}
+	if dir := os.Getenv(ArtifactDirVar); dir != "" {
+		return dir
+	}
+	return filepath.Join(os.TempDir(), "toolchain-e2e-artifacts")
+}
+
+var unsafeFileNameChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+func sanitizeFileName(name string) string {
+	return unsafeFileNameChars.ReplaceAllString(name, "_")
+}
+
+type diagnosticsCollector struct {
+	t            testing.TB
+	awaitilities Awaitilities
+	since        time.Time
+	dir          string
+}
+
+func (c *diagnosticsCollector) collect(tracked []trackedObject) {
+	host := c.awaitilities.Host()
+	userNamespaces := map[*Awaitility][]string{}
+
+	// the objects touched by the test and the ones related to them
+	for _, tr := range tracked {
+		obj := c.writeObject(tr.await, tr.object)
+		switch obj := obj.(type) {
+		case *toolchainv1alpha1.UserSignup:
+			if obj.Status.CompliantUsername != "" {
+				c.writeUserObjects(obj.Status.CompliantUsername, userNamespaces)
+			}
+		case *toolchainv1alpha1.Space:
+			c.writeSpaceObjects(obj.Name, userNamespaces)
+		}
+	}
+	c.writeObject(host.Awaitility, &toolchainv1alpha1.ToolchainStatus{
+		ObjectMeta: metav1.ObjectMeta{Namespace: host.Namespace, Name: "toolchain-status"},
+	})
+
+	// the events and logs of the operators
+	c.writeEvents(host.Awaitility, host.Namespace)
+	c.writeLogs(host.Awaitility, host.Namespace)
+	if host.RegistrationServiceNs != "" && host.RegistrationServiceNs != host.Namespace {
+		c.writeEvents(host.Awaitility, host.RegistrationServiceNs)
+		c.writeLogs(host.Awaitility, host.RegistrationServiceNs)
+	}
+	for _, member := range c.awaitilities.AllMembers() {
+		c.writeEvents(member.Awaitility, member.Namespace)
+		c.writeLogs(member.Awaitility, member.Namespace)
+	}
+
+	// the events of the user namespaces
+	for a, namespaces := range userNamespaces {
+		for _, ns := range namespaces {
+			c.writeEvents(a, ns)
+		}
+	}
+}
+
+// writeUserObjects writes the MasterUserRecord, Space, SpaceBindings, UserAccounts and NSTemplateSets of the user
+func (c *diagnosticsCollector) writeUserObjects(username string, userNamespaces map[*Awaitility][]string) {
+	host := c.awaitilities.Host()
+	c.writeObject(host.Awaitility, &toolchainv1alpha1.MasterUserRecord{
+		ObjectMeta: metav1.ObjectMeta{Namespace: host.Namespace, Name: username},
+	})
+	c.writeList(host.Awaitility, &toolchainv1alpha1.SpaceBindingList{}, client.InNamespace(host.Namespace),
+		client.MatchingLabels{toolchainv1alpha1.SpaceBindingMasterUserRecordLabelKey: username})
+	for _, member := range c.awaitilities.AllMembers() {
+		c.writeObject(member.Awaitility, &toolchainv1alpha1.UserAccount{
+			ObjectMeta: metav1.ObjectMeta{Namespace: member.Namespace, Name: username},
+		})
+	}
+	c.writeSpaceObjects(username, userNamespaces)
+}
+
+// writeSpaceObjects writes the Space, its SpaceBindings and its NSTemplateSet, and collects its namespaces
+func (c *diagnosticsCollector) writeSpaceObjects(name string, userNamespaces map[*Awaitility][]string) {
+	host := c.awaitilities.Host()
+	c.writeObject(host.Awaitility, &toolchainv1alpha1.Space{
+		ObjectMeta: metav1.ObjectMeta{Namespace: host.Namespace, Name: name},
+	})
+	c.writeList(host.Awaitility, &toolchainv1alpha1.SpaceBindingList{}, client.InNamespace(host.Namespace),
+		client.MatchingLabels{toolchainv1alpha1.SpaceBindingSpaceLabelKey: name})
+	for _, member := range c.awaitilities.AllMembers() {
+		obj := c.writeObject(member.Awaitility, &toolchainv1alpha1.NSTemplateSet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: member.Namespace, Name: name},
+		})
+		if nsTmplSet, ok := obj.(*toolchainv1alpha1.NSTemplateSet); ok {
+			for _, ns := range nsTmplSet.Status.ProvisionedNamespaces {
+				userNamespaces[member.Awaitility] = append(userNamespaces[member.Awaitility], ns.Name)
+			}
+		}
+	}
+}
+
+// writeObject writes the latest version of the given object in the `objects` directory, and returns it. If the object
+// doesn't exist anymore, then nothing is written and nil is returned
+func (c *diagnosticsCollector) writeObject(a *Awaitility, obj client.Object) client.Object {
+	latest := obj.DeepCopyObject().(client.Object)
+	if err := a.Client.Get(context.TODO(), client.ObjectKeyFromObject(obj), latest); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		c.t.Logf("unable to get the object '%s' for the diagnostics: %s", client.ObjectKeyFromObject(obj), err)
+		return nil
+	}
+	content, err := StringifyObject(latest)
+	if err != nil {
+		c.t.Logf("unable to render the object '%s' for the diagnostics: %s", client.ObjectKeyFromObject(obj), err)
+		return nil
+	}
+	kind := "object"
+	if gvk, err := apiutil.GVKForObject(latest, a.Client.Scheme()); err == nil {
+		kind = gvk.Kind
+	}
+	c.write(filepath.Join("objects", fmt.Sprintf("%s-%s-%s-%s.yaml", a.ClusterName, kind, latest.GetNamespace(), latest.GetName())), content)
+	return latest
+}
+
+func (c *diagnosticsCollector) writeList(a *Awaitility, list client.ObjectList, opts ...client.ListOption) {
+	if err := a.Client.List(context.TODO(), list, opts...); err != nil {
+		c.t.Logf("unable to list the objects for the diagnostics: %s", err)
+		return
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		c.t.Logf("unable to extract the objects for the diagnostics: %s", err)
+		return
+	}
+	for _, item := range items {
+		if obj, ok := item.(client.Object); ok {
+			c.writeObject(a, obj)
+		}
+	}
+}
+
+// writeEvents writes the events of the namespace which occurred since the start of the test
+func (c *diagnosticsCollector) writeEvents(a *Awaitility, namespace string) {
+	events := &corev1.EventList{}
+	if err := a.Client.List(context.TODO(), events, client.InNamespace(namespace)); err != nil {
+		c.t.Logf("unable to list the events of namespace '%s' for the diagnostics: %s", namespace, err)
+		return
+	}
+	lines := make([]string, 0, len(events.Items))
+	sort.Slice(events.Items, func(i, j int) bool {
+		return eventTime(events.Items[i]).Before(eventTime(events.Items[j]))
+	})
+	for _, e := range events.Items {
+		if eventTime(e).Before(c.since) {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s\t%s\t%s\t%s/%s\t%s (x%d)",
+			eventTime(e).Format(time.RFC3339), e.Type, e.Reason, e.InvolvedObject.Kind, e.InvolvedObject.Name, e.Message, max(e.Count, 1)))
+	}
+	if len(lines) == 0 {
+		return
+	}
+	c.write(filepath.Join("events", fmt.Sprintf("%s-%s.log", a.ClusterName, namespace)), []byte(strings.Join(lines, "\n")+"\n"))
+}
+
+func eventTime(e corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.CreationTimestamp.Time
+	}
+}
+
+// writeLogs writes the logs of all the containers of the pods of the namespace since the start of the test
+func (c *diagnosticsCollector) writeLogs(a *Awaitility, namespace string) {
+	clientset, err := kubernetes.NewForConfig(a.RestConfig)
+	if err != nil {
+		c.t.Logf("unable to create the clientset to retrieve the logs for the diagnostics: %s", err)
+		return
+	}
+	pods := &corev1.PodList{}
+	if err := a.Client.List(context.TODO(), pods, client.InNamespace(namespace)); err != nil {
+		c.t.Logf("unable to list the pods of namespace '%s' for the diagnostics: %s", namespace, err)
+		return
+	}
+	since := metav1.NewTime(c.since)
+	for _, pod := range pods.Items {
+		for _, container := range pod.Spec.Containers {
+			logs, err := clientset.CoreV1().Pods(namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+				Container: container.Name,
+				SinceTime: &since,
+			}).Stream(context.TODO())
+			if err != nil {
+				c.t.Logf("unable to get the logs of the container '%s' of the pod '%s/%s' for the diagnostics: %s", container.Name, namespace, pod.Name, err)
+				continue
+			}
+			content, err := io.ReadAll(logs)
+			_ = logs.Close()
+			if err != nil {
+				c.t.Logf("unable to read the logs of the container '%s' of the pod '%s/%s' for the diagnostics: %s", container.Name, namespace, pod.Name, err)
+			}
+			c.write(filepath.Join("logs", fmt.Sprintf("%s-%s-%s-%s.log", a.ClusterName, namespace, pod.Name, container.Name)), content)
+		}
+	}
+}
+
+func (c *diagnosticsCollector) write(path string, content []byte) {
+	path = filepath.Join(c.dir, path)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		c.t.Logf("unable to create the directory of the diagnostics file '%s': %s", path, err)
+		return
+	}
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		c.t.Logf("unable to write the diagnostics file '%s': %s", path, err)
+	}
+}
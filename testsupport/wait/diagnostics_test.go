@@ -0,0 +1,117 @@
+package wait
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestIsSubtestOf(t *testing.T) {
+	assert.True(t, isSubtestOf("TestSignup", "TestSignup"))
+	assert.True(t, isSubtestOf("TestSignup/approved/banned", "TestSignup"))
+	assert.True(t, isSubtestOf("TestSignup/approved/banned", "TestSignup/approved"))
+	assert.False(t, isSubtestOf("TestSignupWithSocialEvent", "TestSignup"))
+	assert.False(t, isSubtestOf("TestSignup", "TestSignup/approved"))
+}
+
+func TestCollectDiagnosticsOnFailure(t *testing.T) {
+	await := &Awaitility{}
+	object := func(name string) client.Object {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+	tracked := func(name string) int {
+		tracking.Lock()
+		defer tracking.Unlock()
+		return len(tracking.objects[name])
+	}
+	collecting := func(name string) bool {
+		tracking.Lock()
+		defer tracking.Unlock()
+		return tracking.collecting[name]
+	}
+
+	t.Run("registered on the subtest which calls it first", func(t *testing.T) {
+		// when
+		t.Run("first", func(t *testing.T) {
+			CollectDiagnosticsOnFailure(t, Awaitilities{})
+			await.Track(t, object("first"))
+			require.True(t, collecting(t.Name()))
+		})
+		t.Run("second", func(t *testing.T) {
+			CollectDiagnosticsOnFailure(t, Awaitilities{})
+			require.True(t, collecting(t.Name()))
+		})
+
+		// then
+		assert.False(t, collecting(t.Name()+"/first"))
+		assert.False(t, collecting(t.Name()+"/second"))
+		assert.Zero(t, tracked(t.Name()+"/first"))
+	})
+
+	t.Run("not registered again on the subtests", func(t *testing.T) {
+		// given
+		parent := t.Name()
+		t.Run("collecting", func(t *testing.T) {
+			CollectDiagnosticsOnFailure(t, Awaitilities{})
+
+			// when
+			t.Run("subtest", func(t *testing.T) {
+				CollectDiagnosticsOnFailure(t, Awaitilities{})
+				await.Track(t, object("subtest"))
+				require.False(t, collecting(t.Name()))
+			})
+
+			// then
+			assert.True(t, collecting(t.Name()))
+			assert.Equal(t, 1, tracked(t.Name()+"/subtest")) // until the end of the test which collects the diagnostics
+		})
+		assert.Zero(t, tracked(parent+"/collecting/subtest"))
+	})
+}
+
+func TestSanitizeFileName(t *testing.T) {
+	assert.Equal(t, "TestSignup_approved_user_with_space_", sanitizeFileName("TestSignup/approved user with 'space'"))
+}
+
+func TestEventTime(t *testing.T) {
+	// given
+	created := time.Now().Add(-time.Hour).Truncate(time.Second)
+	last := time.Now().Truncate(time.Second)
+
+	t.Run("last timestamp", func(t *testing.T) {
+		// when
+		result := eventTime(corev1.Event{
+			ObjectMeta:    metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+			LastTimestamp: metav1.NewTime(last),
+		})
+
+		// then
+		assert.Equal(t, last, result)
+	})
+
+	t.Run("event time", func(t *testing.T) {
+		// when
+		result := eventTime(corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+			EventTime:  metav1.NewMicroTime(last),
+		})
+
+		// then
+		assert.Equal(t, last, result)
+	})
+
+	t.Run("creation timestamp", func(t *testing.T) {
+		// when
+		result := eventTime(corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+		})
+
+		// then
+		assert.Equal(t, created, result)
+	})
+}
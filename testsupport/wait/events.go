@@ -0,0 +1,41 @@
+package wait
+
+import (
+	"regexp"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// WaitForEvent waits until an Event with the given reason and a message matching the regular expression is recorded for the given
+// object, and returns it. The Events of the cluster-scoped objects are looked up in the `default` namespace.
+func (a *Awaitility) WaitForEvent(t testing.TB, involvedObject client.Object, reason string, messageRegex *regexp.Regexp) (*corev1.Event, error) {
+	gvk, err := apiutil.GVKForObject(involvedObject, a.Client.Scheme())
+	if err != nil {
+		return nil, err
+	}
+	namespace := involvedObject.GetNamespace()
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	t.Logf("waiting for an event with reason '%s' and a message matching '%s' for the %s '%s'", reason, messageRegex, gvk.Kind, client.ObjectKeyFromObject(involvedObject))
+	return For(t, a, &corev1.Event{}).
+		InNamespace(namespace).
+		FirstThat(&customPredicate[*corev1.Event]{
+			predicate: func(e *corev1.Event) bool {
+				return isEventFor(e, gvk.Kind, involvedObject) && e.Reason == reason && messageRegex.MatchString(e.Message)
+			},
+		})
+}
+
+// isEventFor returns true if the event is about the given object. The UID is only compared when it's set on the object,
+// so that the events can be looked up by kind and name only.
+func isEventFor(e *corev1.Event, kind string, obj client.Object) bool {
+	return e.InvolvedObject.Kind == kind &&
+		e.InvolvedObject.Name == obj.GetName() &&
+		e.InvolvedObject.Namespace == obj.GetNamespace() &&
+		(obj.GetUID() == "" || e.InvolvedObject.UID == obj.GetUID())
+}
@@ -0,0 +1,36 @@
+package wait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsEventFor(t *testing.T) {
+	// given
+	event := &corev1.Event{
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Namespace: "test", Name: "pod", UID: "123"},
+	}
+
+	t.Run("same kind and name", func(t *testing.T) {
+		assert.True(t, isEventFor(event, "Pod", &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "pod"}}))
+	})
+
+	t.Run("same kind, name and uid", func(t *testing.T) {
+		assert.True(t, isEventFor(event, "Pod", &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "pod", UID: "123"}}))
+	})
+
+	t.Run("other uid", func(t *testing.T) {
+		assert.False(t, isEventFor(event, "Pod", &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "pod", UID: "456"}}))
+	})
+
+	t.Run("other name", func(t *testing.T) {
+		assert.False(t, isEventFor(event, "Pod", &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "other"}}))
+	})
+
+	t.Run("other kind", func(t *testing.T) {
+		assert.False(t, isEventFor(event, "Service", &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "pod"}}))
+	})
+}
@@ -27,7 +27,6 @@ import (
 	templatev1 "github.com/openshift/api/template/v1"
 	userv1 "github.com/openshift/api/user/v1"
 	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
-	goerr "github.com/pkg/errors"
 	"github.com/redhat-cop/operator-utils/pkg/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -42,7 +41,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/metrics/pkg/apis/metrics"
@@ -92,7 +90,7 @@ func (a *HostAwaitility) WithRetryOptions(options ...RetryOption) *HostAwaitilit
 
 // WaitForMetricsService verifies that there is a service called `host-operator-metrics-service`
 // in the host namespace.
-func (a *HostAwaitility) WaitForMetricsService(t *testing.T) {
+func (a *HostAwaitility) WaitForMetricsService(t testing.TB) {
 	_, err := a.WaitForService(t, "host-operator-metrics-service")
 	require.NoError(t, err, "failed while waiting for 'host-operator-metrics-service' service")
 }
@@ -124,7 +122,7 @@ const (
 )
 
 // InitMetricsAssertion waits for any pending usersignups and then initialized the metrics assertion helper with baseline values
-func (a *HostAwaitility) InitMetrics(t *testing.T, memberClusterNames ...string) {
+func (a *HostAwaitility) InitMetrics(t testing.TB, memberClusterNames ...string) {
 	// Wait for pending usersignup deletions before capturing baseline values so that test assertions are stable
 	err := a.WaitForTestResourcesCleanup(t, 10*time.Second)
 	require.NoError(t, err)
@@ -174,23 +172,27 @@ func (a *HostAwaitility) InitMetrics(t *testing.T, memberClusterNames ...string)
 }
 
 // WaitForMasterUserRecord waits until there is a MasterUserRecord available with the given name and the optional conditions
-func (a *HostAwaitility) WaitForMasterUserRecord(t *testing.T, name string, criteria ...MasterUserRecordWaitCriterion) (*toolchainv1alpha1.MasterUserRecord, error) {
+func (a *HostAwaitility) WaitForMasterUserRecord(t testing.TB, name string, criteria ...MasterUserRecordWaitCriterion) (*toolchainv1alpha1.MasterUserRecord, error) {
 	t.Logf("waiting for MasterUserRecord '%s' in namespace '%s' to match criteria", name, a.Namespace)
 	var mur *toolchainv1alpha1.MasterUserRecord
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.MasterUserRecord{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &toolchainv1alpha1.MasterUserRecord{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
 			return false, err
 		}
 		mur = obj
-		return matchMasterUserRecordWaitCriterion(obj, criteria...), nil
+		return matchWaitCriteria(obj, criteria...), nil
 	})
 	// no match found, print the diffs
 	if err != nil {
-		a.printMasterUserRecordWaitCriterionDiffs(t, mur, criteria...)
+		// also include other resources relevant in the host namespace, to help troubleshooting
+		a.listAndPrint(t, "UserSignups", a.Namespace, &toolchainv1alpha1.UserSignupList{})
+		a.listAndPrint(t, "MasterUserRecords", a.Namespace, &toolchainv1alpha1.MasterUserRecordList{})
+		a.listAndPrint(t, "Spaces", a.Namespace, &toolchainv1alpha1.SpaceList{})
+		printWaitCriteriaDiffs(t, "MasterUserRecord", mur, criteria...)
 	}
 	return mur, err
 }
@@ -203,47 +205,6 @@ func (a *HostAwaitility) GetMasterUserRecord(name string) (*toolchainv1alpha1.Ma
 	return mur, nil
 }
 
-// MasterUserRecordWaitCriterion a struct to compare with an expected MasterUserRecord
-type MasterUserRecordWaitCriterion struct {
-	Match func(*toolchainv1alpha1.MasterUserRecord) bool
-	Diff  func(*toolchainv1alpha1.MasterUserRecord) string
-}
-
-func matchMasterUserRecordWaitCriterion(actual *toolchainv1alpha1.MasterUserRecord, criteria ...MasterUserRecordWaitCriterion) bool {
-	for _, c := range criteria {
-		if !c.Match(actual) {
-			return false
-		}
-	}
-	return true
-}
-
-func (a *HostAwaitility) printMasterUserRecordWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.MasterUserRecord, criteria ...MasterUserRecordWaitCriterion) {
-	buf := &strings.Builder{}
-	if actual == nil {
-		fmt.Fprintln(buf, "failed to find MasterUserRecord")
-	} else {
-		fmt.Fprintln(buf, "failed to find MasterUserRecord with matching criteria:")
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "actual:")
-		y, _ := StringifyObject(actual)
-		fmt.Fprintln(buf, string(y))
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "diffs:")
-		for _, c := range criteria {
-			if !c.Match(actual) && c.Diff != nil {
-				fmt.Fprintln(buf, c.Diff(actual))
-			}
-		}
-	}
-	// also include other resources relevant in the host namespace, to help troubleshooting
-	a.listAndPrint(t, "UserSignups", a.Namespace, &toolchainv1alpha1.UserSignupList{})
-	a.listAndPrint(t, "MasterUserRecords", a.Namespace, &toolchainv1alpha1.MasterUserRecordList{})
-	a.listAndPrint(t, "Spaces", a.Namespace, &toolchainv1alpha1.SpaceList{})
-
-	t.Log(buf.String())
-}
-
 // UntilMasterUserRecordIsBeingDeleted checks if MasterUserRecord has Deletion Timestamp
 func UntilMasterUserRecordIsBeingDeleted() MasterUserRecordWaitCriterion {
 	return MasterUserRecordWaitCriterion{
@@ -374,44 +335,6 @@ func UntilMasterUserRecordHasNoTierHashLabel() MasterUserRecordWaitCriterion {
 	}
 }
 
-// UserSignupWaitCriterion a struct to compare with an expected UserSignup
-type UserSignupWaitCriterion struct {
-	Match func(*toolchainv1alpha1.UserSignup) bool
-	Diff  func(*toolchainv1alpha1.UserSignup) string
-}
-
-func matchUserSignupWaitCriterion(actual *toolchainv1alpha1.UserSignup, criteria ...UserSignupWaitCriterion) bool {
-	for _, c := range criteria {
-		if !c.Match(actual) {
-			return false
-		}
-	}
-	return true
-}
-
-func (a *HostAwaitility) printUserSignupWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.UserSignup, criteria ...UserSignupWaitCriterion) {
-	buf := &strings.Builder{}
-	if actual == nil {
-		buf.WriteString("failed to find UserSignup\n")
-	} else {
-		buf.WriteString("failed to find UserSignup with matching criteria:\n")
-		fmt.Fprintln(buf, "actual:")
-		y, _ := StringifyObject(actual)
-		fmt.Fprintln(buf, string(y))
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "diffs:")
-		for _, c := range criteria {
-			if !c.Match(actual) && c.Diff != nil {
-				fmt.Fprintln(buf, c.Diff(actual))
-			}
-		}
-	}
-	// include also all UserSignups in the host namespace, to help troubleshooting
-	a.listAndPrint(t, "UserSignups", a.Namespace, &toolchainv1alpha1.UserSignupList{})
-
-	t.Log(buf.String())
-}
-
 // UntilUserSignupIsBeingDeleted returns a `UserSignupWaitCriterion` which checks that the given
 // UserSignup has deletion timestamp set
 func UntilUserSignupIsBeingDeleted() UserSignupWaitCriterion {
@@ -576,12 +499,12 @@ func UntilUserSignupHasCompliantUsername() UserSignupWaitCriterion {
 }
 
 // WaitForTestResourcesCleanup waits for all UserSignup, MasterUserRecord, Space, SpaceBinding, NSTemplateSet and Namespace deletions to complete
-func (a *HostAwaitility) WaitForTestResourcesCleanup(t *testing.T, initialDelay time.Duration) error {
+func (a *HostAwaitility) WaitForTestResourcesCleanup(t testing.TB, initialDelay time.Duration) error {
 	t.Logf("waiting for resource cleanup")
 	time.Sleep(initialDelay)
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	return a.waitUntil(t, &toolchainv1alpha1.UserSignup{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		usList := &toolchainv1alpha1.UserSignupList{}
-		if err := a.Client.List(context.TODO(), usList, client.InNamespace(a.Namespace)); err != nil {
+		if err := a.Client.List(ctx, usList, client.InNamespace(a.Namespace)); err != nil {
 			return false, err
 		}
 		for _, us := range usList.Items {
@@ -591,7 +514,7 @@ func (a *HostAwaitility) WaitForTestResourcesCleanup(t *testing.T, initialDelay
 		}
 
 		murList := &toolchainv1alpha1.MasterUserRecordList{}
-		if err := a.Client.List(context.TODO(), murList, client.InNamespace(a.Namespace)); err != nil {
+		if err := a.Client.List(ctx, murList, client.InNamespace(a.Namespace)); err != nil {
 			return false, err
 		}
 		for _, mur := range murList.Items {
@@ -601,7 +524,7 @@ func (a *HostAwaitility) WaitForTestResourcesCleanup(t *testing.T, initialDelay
 		}
 
 		spaceBindingList := &toolchainv1alpha1.SpaceBindingList{}
-		if err := a.Client.List(context.TODO(), spaceBindingList, client.InNamespace(a.Namespace)); err != nil {
+		if err := a.Client.List(ctx, spaceBindingList, client.InNamespace(a.Namespace)); err != nil {
 			return false, err
 		}
 		for _, spaceBinding := range spaceBindingList.Items {
@@ -611,7 +534,7 @@ func (a *HostAwaitility) WaitForTestResourcesCleanup(t *testing.T, initialDelay
 		}
 
 		spaceList := &toolchainv1alpha1.SpaceList{}
-		if err := a.Client.List(context.TODO(), spaceList, client.InNamespace(a.Namespace)); err != nil {
+		if err := a.Client.List(ctx, spaceList, client.InNamespace(a.Namespace)); err != nil {
 			return false, err
 		}
 		for _, space := range spaceList.Items {
@@ -621,7 +544,7 @@ func (a *HostAwaitility) WaitForTestResourcesCleanup(t *testing.T, initialDelay
 		}
 
 		nsTemplateSetList := &toolchainv1alpha1.NSTemplateSetList{}
-		if err := a.Client.List(context.TODO(), nsTemplateSetList); err != nil {
+		if err := a.Client.List(ctx, nsTemplateSetList); err != nil {
 			return false, err
 		}
 		for _, nsTemplateSet := range nsTemplateSetList.Items {
@@ -631,7 +554,7 @@ func (a *HostAwaitility) WaitForTestResourcesCleanup(t *testing.T, initialDelay
 		}
 
 		namespaceList := &corev1.NamespaceList{}
-		if err := a.Client.List(context.TODO(), namespaceList); err != nil {
+		if err := a.Client.List(ctx, namespaceList); err != nil {
 			return false, err
 		}
 		for _, namespace := range namespaceList.Items {
@@ -644,37 +567,39 @@ func (a *HostAwaitility) WaitForTestResourcesCleanup(t *testing.T, initialDelay
 }
 
 // WaitForUserSignup waits until there is a UserSignup available with the given name and set of status conditions
-func (a *HostAwaitility) WaitForUserSignup(t *testing.T, name string, criteria ...UserSignupWaitCriterion) (*toolchainv1alpha1.UserSignup, error) {
+func (a *HostAwaitility) WaitForUserSignup(t testing.TB, name string, criteria ...UserSignupWaitCriterion) (*toolchainv1alpha1.UserSignup, error) {
 	t.Logf("waiting for UserSignup '%s' in namespace '%s' to match criteria", name, a.Namespace)
 	var userSignup *toolchainv1alpha1.UserSignup
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.UserSignup{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &toolchainv1alpha1.UserSignup{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
 			return false, err
 		}
 		userSignup = obj
-		return matchUserSignupWaitCriterion(userSignup, criteria...), nil
+		return matchWaitCriteria(userSignup, criteria...), nil
 	})
 	// no match found, print the diffs
 	if err != nil {
-		a.printUserSignupWaitCriterionDiffs(t, userSignup, criteria...)
+		// include also all UserSignups in the host namespace, to help troubleshooting
+		a.listAndPrint(t, "UserSignups", a.Namespace, &toolchainv1alpha1.UserSignupList{})
+		printWaitCriteriaDiffs(t, "UserSignup", userSignup, criteria...)
 	}
 	return userSignup, err
 }
 
 // WaitForUserSignup waits until there is a UserSignup available with the given name and set of status conditions
-func (a *HostAwaitility) WaitForUserSignupByUserIDAndUsername(t *testing.T, userID, username string, criteria ...UserSignupWaitCriterion) (*toolchainv1alpha1.UserSignup, error) {
+func (a *HostAwaitility) WaitForUserSignupByUserIDAndUsername(t testing.TB, userID, username string, criteria ...UserSignupWaitCriterion) (*toolchainv1alpha1.UserSignup, error) {
 	t.Logf("waiting for UserSignup '%s' or '%s' in namespace '%s' to match criteria", userID, username, a.Namespace)
 	encodedUsername := EncodeUserIdentifier(username)
 	var userSignup *toolchainv1alpha1.UserSignup
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.UserSignup{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &toolchainv1alpha1.UserSignup{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: userID}, obj); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Namespace: a.Namespace, Name: userID}, obj); err != nil {
 			if errors.IsNotFound(err) {
-				if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: encodedUsername}, obj); err != nil {
+				if err := a.Client.Get(ctx, types.NamespacedName{Namespace: a.Namespace, Name: encodedUsername}, obj); err != nil {
 					if errors.IsNotFound(err) {
 						return false, nil
 					}
@@ -685,44 +610,32 @@ func (a *HostAwaitility) WaitForUserSignupByUserIDAndUsername(t *testing.T, user
 			}
 		}
 		userSignup = obj
-		return matchUserSignupWaitCriterion(userSignup, criteria...), nil
+		return matchWaitCriteria(userSignup, criteria...), nil
 	})
 	// no match found, print the diffs
 	if err != nil {
-		a.printUserSignupWaitCriterionDiffs(t, userSignup, criteria...)
+		// include also all UserSignups in the host namespace, to help troubleshooting
+		a.listAndPrint(t, "UserSignups", a.Namespace, &toolchainv1alpha1.UserSignupList{})
+		printWaitCriteriaDiffs(t, "UserSignup", userSignup, criteria...)
 	}
 	return userSignup, err
 }
 
 // WaitAndVerifyThatUserSignupIsNotCreated waits and checks that the UserSignup is not created
-func (a *HostAwaitility) WaitAndVerifyThatUserSignupIsNotCreated(t *testing.T, name string) {
-	t.Logf("waiting and verifying that UserSignup '%s' in namespace '%s' is not created", name, a.Namespace)
-	var userSignup *toolchainv1alpha1.UserSignup
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
-		obj := &toolchainv1alpha1.UserSignup{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
-			if errors.IsNotFound(err) {
-				return false, nil
-			}
-			return false, err
-		}
-		userSignup = obj
-		return true, nil
-	})
-	if err == nil {
-		require.Fail(t, fmt.Sprintf("UserSignup '%s' should not be created, but it was found: %v", name, userSignup))
-	}
+func (a *HostAwaitility) WaitAndVerifyThatUserSignupIsNotCreated(t testing.TB, name string) {
+	err := For(t, a.Awaitility, &toolchainv1alpha1.UserSignup{}).NeverExists(name, a.Timeout)
+	require.NoError(t, err, "UserSignup '%s' should not be created", name)
 }
 
 // WaitForBannedUser waits until there is a BannedUser available with the given email hash
 // !!! WARNING: for now, just used for WA
-func (a *HostAwaitility) WaitForBannedUser(t *testing.T, userEmailHash string) (*toolchainv1alpha1.BannedUser, error) {
+func (a *HostAwaitility) WaitForBannedUser(t testing.TB, userEmailHash string) (*toolchainv1alpha1.BannedUser, error) {
 	t.Logf("waiting for BannedUser for user email hash '%s' in namespace '%s'", userEmailHash, a.Namespace)
 	var bannedUser *toolchainv1alpha1.BannedUser
 	emailHashLabelMatch := client.MatchingLabels(map[string]string{
 		toolchainv1alpha1.BannedUserEmailHashLabelKey: userEmailHash,
 	})
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.BannedUser{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		bannedUserList := &toolchainv1alpha1.BannedUserList{}
 		if err := a.Client.List(ctx, bannedUserList, emailHashLabelMatch, client.InNamespace(a.Namespace)); err != nil {
 			return false, err
@@ -741,7 +654,7 @@ func (a *HostAwaitility) WaitForBannedUser(t *testing.T, userEmailHash string) (
 }
 
 // DeleteToolchainStatus deletes the ToolchainStatus resource with the given name and in the host operator namespace
-func (a *HostAwaitility) DeleteToolchainStatus(t *testing.T, name string) error {
+func (a *HostAwaitility) DeleteToolchainStatus(t testing.TB, name string) error {
 	t.Logf("deleting ToolchainStatus '%s' in namespace '%s'", name, a.Namespace)
 	toolchainstatus := &toolchainv1alpha1.ToolchainStatus{}
 	if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, toolchainstatus); err != nil {
@@ -754,11 +667,11 @@ func (a *HostAwaitility) DeleteToolchainStatus(t *testing.T, name string) error
 }
 
 // WaitUntilBannedUserDeleted waits until the BannedUser with the given name is deleted (ie, not found)
-func (a *HostAwaitility) WaitUntilBannedUserDeleted(t *testing.T, name string) error {
+func (a *HostAwaitility) WaitUntilBannedUserDeleted(t testing.TB, name string) error {
 	t.Logf("waiting until BannedUser '%s' in namespace '%s' is deleted", name, a.Namespace)
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	return a.waitUntilWatched(t, &toolchainv1alpha1.BannedUser{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		user := &toolchainv1alpha1.BannedUser{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, user); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Namespace: a.Namespace, Name: name}, user); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -769,11 +682,11 @@ func (a *HostAwaitility) WaitUntilBannedUserDeleted(t *testing.T, name string) e
 }
 
 // WaitUntilUserSignupDeleted waits until the UserSignup with the given name is deleted (ie, not found)
-func (a *HostAwaitility) WaitUntilUserSignupDeleted(t *testing.T, name string) error {
+func (a *HostAwaitility) WaitUntilUserSignupDeleted(t testing.TB, name string) error {
 	t.Logf("waiting until UserSignup '%s' in namespace '%s is deleted", name, a.Namespace)
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	return a.waitUntilWatched(t, &toolchainv1alpha1.UserSignup{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		userSignup := &toolchainv1alpha1.UserSignup{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, userSignup); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Namespace: a.Namespace, Name: name}, userSignup); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -784,11 +697,11 @@ func (a *HostAwaitility) WaitUntilUserSignupDeleted(t *testing.T, name string) e
 }
 
 // WaitUntilMasterUserRecordAndSpaceBindingsDeleted waits until the MUR with the given name and its associated SpaceBindings are deleted (ie, not found)
-func (a *HostAwaitility) WaitUntilMasterUserRecordAndSpaceBindingsDeleted(t *testing.T, name string) error {
+func (a *HostAwaitility) WaitUntilMasterUserRecordAndSpaceBindingsDeleted(t testing.TB, name string) error {
 	t.Logf("waiting until MasterUserRecord '%s' in namespace '%s' is deleted", name, a.Namespace)
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	return a.waitUntil(t, &toolchainv1alpha1.MasterUserRecord{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		mur := &toolchainv1alpha1.MasterUserRecord{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, mur); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Namespace: a.Namespace, Name: name}, mur); err != nil {
 			if errors.IsNotFound(err) {
 				// once the MUR is deleted, wait for the associated spacebindings to be deleted as well
 				if err := a.WaitUntilSpaceBindingsWithLabelDeleted(t, toolchainv1alpha1.SpaceBindingMasterUserRecordLabelKey, name); err != nil {
@@ -803,11 +716,11 @@ func (a *HostAwaitility) WaitUntilMasterUserRecordAndSpaceBindingsDeleted(t *tes
 }
 
 // CheckMasterUserRecordIsDeleted checks that the MUR with the given name is not present and won't be created in the next 2 seconds
-func (a *HostAwaitility) CheckMasterUserRecordIsDeleted(t *testing.T, name string) {
+func (a *HostAwaitility) CheckMasterUserRecordIsDeleted(t testing.TB, name string) {
 	t.Logf("checking that MasterUserRecord '%s' in namespace '%s' is deleted", name, a.Namespace)
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, 2*time.Second, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.MasterUserRecord{}, a.Namespace, 2*time.Second, func(ctx context.Context) (done bool, err error) {
 		mur := &toolchainv1alpha1.MasterUserRecord{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, mur); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Namespace: a.Namespace, Name: name}, mur); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -829,12 +742,12 @@ func containsUserAccountStatus(uaStatuses []toolchainv1alpha1.UserAccountStatusE
 }
 
 // WaitForUserTier waits until an UserTier with the given name exists and matches any given criteria
-func (a *HostAwaitility) WaitForUserTier(t *testing.T, name string, criteria ...UserTierWaitCriterion) (*toolchainv1alpha1.UserTier, error) {
+func (a *HostAwaitility) WaitForUserTier(t testing.TB, name string, criteria ...UserTierWaitCriterion) (*toolchainv1alpha1.UserTier, error) {
 	t.Logf("waiting until UserTier '%s' in namespace '%s' matches criteria", name, a.Namespace)
 	tier := &toolchainv1alpha1.UserTier{}
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.UserTier{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &toolchainv1alpha1.UserTier{}
-		err = a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj)
+		err = a.Client.Get(ctx, types.NamespacedName{Namespace: a.Namespace, Name: name}, obj)
 		if err != nil && !errors.IsNotFound(err) {
 			// return the error
 			return false, err
@@ -843,52 +756,15 @@ func (a *HostAwaitility) WaitForUserTier(t *testing.T, name string, criteria ...
 			return false, nil
 		}
 		tier = obj
-		return matchUserTierWaitCriterion(obj, criteria...), nil
+		return matchWaitCriteria(obj, criteria...), nil
 	})
 	// no match found, print the diffs
 	if err != nil {
-		a.printUserTierWaitCriterionDiffs(t, tier, criteria...)
+		printWaitCriteriaDiffs(t, "UserTier", tier, criteria...)
 	}
 	return tier, err
 }
 
-// UserTierWaitCriterion a struct to compare with an expected UserTier
-type UserTierWaitCriterion struct {
-	Match func(*toolchainv1alpha1.UserTier) bool
-	Diff  func(*toolchainv1alpha1.UserTier) string
-}
-
-func matchUserTierWaitCriterion(actual *toolchainv1alpha1.UserTier, criteria ...UserTierWaitCriterion) bool {
-	for _, c := range criteria {
-		// if at least one criteria does not match, keep waiting
-		if !c.Match(actual) {
-			return false
-		}
-	}
-	return true
-}
-
-func (a *HostAwaitility) printUserTierWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.UserTier, criteria ...UserTierWaitCriterion) {
-	buf := &strings.Builder{}
-	if actual == nil {
-		buf.WriteString("failed to find UserTier\n")
-	} else {
-		buf.WriteString("failed to find UserTier with matching criteria:\n")
-		fmt.Fprintln(buf, "actual:")
-		y, _ := StringifyObject(actual)
-		fmt.Fprintln(buf, string(y))
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "diffs:")
-		for _, c := range criteria {
-			if !c.Match(actual) {
-				fmt.Fprintln(buf, c.Diff(actual))
-			}
-		}
-	}
-
-	t.Log(buf.String())
-}
-
 // UntilUserTierHasDeactivationTimeoutDays verify that the UserTier spec.DeactivationTimeoutDays is equal to the expected nummber
 func UntilUserTierHasDeactivationTimeoutDays(expected int) UserTierWaitCriterion {
 	return UserTierWaitCriterion{
@@ -901,23 +777,23 @@ func UntilUserTierHasDeactivationTimeoutDays(expected int) UserTierWaitCriterion
 	}
 }
 
-func (a *HostAwaitility) WaitUntilBaseUserTierIsUpdated(t *testing.T) error {
+func (a *HostAwaitility) WaitUntilBaseUserTierIsUpdated(t testing.TB) error {
 	_, err := a.WaitForUserTier(t, "deactivate30", UntilUserTierHasDeactivationTimeoutDays(30))
 	return err
 }
 
-func (a *HostAwaitility) WaitUntilBaseNSTemplateTierIsUpdated(t *testing.T) error {
+func (a *HostAwaitility) WaitUntilBaseNSTemplateTierIsUpdated(t testing.TB) error {
 	_, err := a.WaitForNSTemplateTier(t, "base", UntilNSTemplateTierSpec(HasNoTemplateRefWithSuffix("-000000a")))
 	return err
 }
 
 // WaitForNSTemplateTier waits until an NSTemplateTier with the given name exists and matches the given conditions
-func (a *HostAwaitility) WaitForNSTemplateTier(t *testing.T, name string, criteria ...NSTemplateTierWaitCriterion) (*toolchainv1alpha1.NSTemplateTier, error) {
+func (a *HostAwaitility) WaitForNSTemplateTier(t testing.TB, name string, criteria ...NSTemplateTierWaitCriterion) (*toolchainv1alpha1.NSTemplateTier, error) {
 	t.Logf("waiting until NSTemplateTier '%s' in namespace '%s' matches criteria", name, a.Namespace)
 	tier := &toolchainv1alpha1.NSTemplateTier{}
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.NSTemplateTier{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &toolchainv1alpha1.NSTemplateTier{}
-		err = a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj)
+		err = a.Client.Get(ctx, types.NamespacedName{Namespace: a.Namespace, Name: name}, obj)
 		if err != nil && !errors.IsNotFound(err) {
 			// return the error
 			return false, err
@@ -926,17 +802,19 @@ func (a *HostAwaitility) WaitForNSTemplateTier(t *testing.T, name string, criter
 			return false, nil
 		}
 		tier = obj
-		return matchNSTemplateTierWaitCriterion(obj, criteria...), nil
+		return matchWaitCriteria(obj, criteria...), nil
 	})
 	// no match found, print the diffs
 	if err != nil {
-		a.printNSTemplateTierWaitCriterionDiffs(t, tier, criteria...)
+		// include also all NSTemplateTiers in the host namespace, to help troubleshooting
+		a.listAndPrint(t, "NSTemplateTiers", a.Namespace, &toolchainv1alpha1.NSTemplateTierList{})
+		printWaitCriteriaDiffs(t, "NSTemplateTier", tier, criteria...)
 	}
 	return tier, err
 }
 
 // WaitForNSTemplateTierAndCheckTemplates waits until an NSTemplateTier with the given name exists matching the given conditions and then it verifies that all expected templates exist
-func (a *HostAwaitility) WaitForNSTemplateTierAndCheckTemplates(t *testing.T, name string, criteria ...NSTemplateTierWaitCriterion) (*toolchainv1alpha1.NSTemplateTier, error) {
+func (a *HostAwaitility) WaitForNSTemplateTierAndCheckTemplates(t testing.TB, name string, criteria ...NSTemplateTierWaitCriterion) (*toolchainv1alpha1.NSTemplateTier, error) {
 	tier, err := a.WaitForNSTemplateTier(t, name, criteria...)
 	if err != nil {
 		return nil, err
@@ -985,7 +863,7 @@ func (a *HostAwaitility) WaitForNSTemplateTierAndCheckTemplates(t *testing.T, na
 	return tier, err
 }
 
-func (a *HostAwaitility) checkTTR(t *testing.T, tier *toolchainv1alpha1.NSTemplateTier, tierTemplate *toolchainv1alpha1.TierTemplate) error {
+func (a *HostAwaitility) checkTTR(t testing.TB, tier *toolchainv1alpha1.NSTemplateTier, tierTemplate *toolchainv1alpha1.TierTemplate) error {
 	// if the tier template supports Tier Template Revisions then let's check those
 	if tierTemplate.Spec.TemplateObjects != nil {
 		// TODO improve this since now it requires that WaitForNSTemplateTierAndCheckTemplates should be called with HasStatusTierTemplateRevisions,
@@ -1002,12 +880,12 @@ func (a *HostAwaitility) checkTTR(t *testing.T, tier *toolchainv1alpha1.NSTempla
 
 // WaitForTierTemplate waits until a TierTemplate with the given name exists
 // Returns an error if the resource did not exist (or something wrong happened)
-func (a *HostAwaitility) WaitForTierTemplate(t *testing.T, name string) (*toolchainv1alpha1.TierTemplate, error) { // nolint:unparam
+func (a *HostAwaitility) WaitForTierTemplate(t testing.TB, name string) (*toolchainv1alpha1.TierTemplate, error) { // nolint:unparam
 	tierTemplate := &toolchainv1alpha1.TierTemplate{}
 	t.Logf("waiting until TierTemplate '%s' exists in namespace '%s'...", name, a.Namespace)
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.TierTemplate{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &toolchainv1alpha1.TierTemplate{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -1039,7 +917,7 @@ func matchTierTemplateRevisionWaitCriterion(actual []toolchainv1alpha1.TierTempl
 	return true
 }
 
-func (a *HostAwaitility) printTierTemplateRevisionWaitCriterionDiffs(t *testing.T, actual []toolchainv1alpha1.TierTemplateRevision, tierName string, criteria ...TierTemplateRevisionWaitCriterion) {
+func (a *HostAwaitility) printTierTemplateRevisionWaitCriterionDiffs(t testing.TB, actual []toolchainv1alpha1.TierTemplateRevision, tierName string, criteria ...TierTemplateRevisionWaitCriterion) {
 	buf := &strings.Builder{}
 	if len(actual) == 0 {
 		buf.WriteString("no ttrs found\n")
@@ -1095,10 +973,10 @@ func LessOrEqual(count int) TierTemplateRevisionWaitCriterion {
 	}
 }
 
-func (a *HostAwaitility) WaitForTTRs(t *testing.T, tierName string, criteria ...TierTemplateRevisionWaitCriterion) ([]toolchainv1alpha1.TierTemplateRevision, error) {
+func (a *HostAwaitility) WaitForTTRs(t testing.TB, tierName string, criteria ...TierTemplateRevisionWaitCriterion) ([]toolchainv1alpha1.TierTemplateRevision, error) {
 	t.Logf("waiting for ttrs to match criteria for tier '%s'", tierName)
 	var ttrs []toolchainv1alpha1.TierTemplateRevision
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.TierTemplateRevision{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		objs := &toolchainv1alpha1.TierTemplateRevisionList{}
 		if err := a.Client.List(ctx, objs, client.InNamespace(a.Namespace), client.MatchingLabels{toolchainv1alpha1.TierLabelKey: tierName}); err != nil {
 			return false, err
@@ -1116,45 +994,6 @@ func (a *HostAwaitility) WaitForTTRs(t *testing.T, tierName string, criteria ...
 	return ttrs, err
 }
 
-// NSTemplateTierWaitCriterion a struct to compare with an expected NSTemplateTier
-type NSTemplateTierWaitCriterion struct {
-	Match func(*toolchainv1alpha1.NSTemplateTier) bool
-	Diff  func(*toolchainv1alpha1.NSTemplateTier) string
-}
-
-func matchNSTemplateTierWaitCriterion(actual *toolchainv1alpha1.NSTemplateTier, criteria ...NSTemplateTierWaitCriterion) bool {
-	for _, c := range criteria {
-		// if at least one criteria does not match, keep waiting
-		if !c.Match(actual) {
-			return false
-		}
-	}
-	return true
-}
-
-func (a *HostAwaitility) printNSTemplateTierWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.NSTemplateTier, criteria ...NSTemplateTierWaitCriterion) {
-	buf := &strings.Builder{}
-	if actual == nil {
-		buf.WriteString("failed to find NSTemplateTier\n")
-	} else {
-		buf.WriteString("failed to find NSTemplateTier with matching criteria:\n")
-		fmt.Fprintln(buf, "actual:")
-		y, _ := StringifyObject(actual)
-		fmt.Fprintln(buf, string(y))
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "diffs:")
-		for _, c := range criteria {
-			if !c.Match(actual) {
-				fmt.Fprintln(buf, c.Diff(actual))
-			}
-		}
-	}
-	// include also all NSTemplateTiers in the host namespace, to help troubleshooting
-	a.listAndPrint(t, "NSTemplateTiers", a.Namespace, &toolchainv1alpha1.NSTemplateTierList{})
-
-	t.Log(buf.String())
-}
-
 // NSTemplateTierSpecMatcher a struct to compare with an expected NSTemplateTierSpec
 type NSTemplateTierSpecMatcher struct {
 	Match func(toolchainv1alpha1.NSTemplateTierSpec) bool
@@ -1272,7 +1111,7 @@ func matchNotificationWaitCriterion(actual []toolchainv1alpha1.Notification, cri
 	return true
 }
 
-func (a *HostAwaitility) printNotificationWaitCriterionDiffs(t *testing.T, actual []toolchainv1alpha1.Notification, criteria ...NotificationWaitCriterion) {
+func (a *HostAwaitility) printNotificationWaitCriterionDiffs(t testing.TB, actual []toolchainv1alpha1.Notification, criteria ...NotificationWaitCriterion) {
 	buf := &strings.Builder{}
 	if len(actual) == 0 {
 		buf.WriteString("no notification found\n")
@@ -1300,14 +1139,14 @@ func (a *HostAwaitility) printNotificationWaitCriterionDiffs(t *testing.T, actua
 }
 
 // WaitForNotifications waits until there is an expected number of Notifications available for the provided user and with the notification type and which match the conditions (if provided).
-func (a *HostAwaitility) WaitForNotifications(t *testing.T, username, notificationType string, numberOfNotifications int, criteria ...NotificationWaitCriterion) ([]toolchainv1alpha1.Notification, error) {
+func (a *HostAwaitility) WaitForNotifications(t testing.TB, username, notificationType string, numberOfNotifications int, criteria ...NotificationWaitCriterion) ([]toolchainv1alpha1.Notification, error) {
 	t.Logf("waiting for notifications to match criteria for user '%s'", username)
 	var notifications []toolchainv1alpha1.Notification
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.Notification{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		labels := map[string]string{toolchainv1alpha1.NotificationUserNameLabelKey: username, toolchainv1alpha1.NotificationTypeLabelKey: notificationType}
 		opts := client.MatchingLabels(labels)
 		notificationList := &toolchainv1alpha1.NotificationList{}
-		if err := a.Client.List(context.TODO(), notificationList, opts); err != nil {
+		if err := a.Client.List(ctx, notificationList, opts); err != nil {
 			return false, err
 		}
 		notifications = notificationList.Items
@@ -1324,12 +1163,12 @@ func (a *HostAwaitility) WaitForNotifications(t *testing.T, username, notificati
 }
 
 // WaitForNotificationWithName waits until there is an expected Notifications available with the provided name and with the notification type and which match the conditions (if provided).
-func (a *HostAwaitility) WaitForNotificationWithName(t *testing.T, notificationName, notificationType string, criteria ...NotificationWaitCriterion) (toolchainv1alpha1.Notification, error) {
+func (a *HostAwaitility) WaitForNotificationWithName(t testing.TB, notificationName, notificationType string, criteria ...NotificationWaitCriterion) (toolchainv1alpha1.Notification, error) {
 	t.Logf("waiting for notification with name '%s'", notificationName)
 	notification := &toolchainv1alpha1.Notification{}
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.Notification{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		notification = &toolchainv1alpha1.Notification{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: notificationName, Namespace: a.Namespace}, notification); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Name: notificationName, Namespace: a.Namespace}, notification); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -1350,37 +1189,22 @@ func (a *HostAwaitility) WaitForNotificationWithName(t *testing.T, notificationN
 	return *notification, err
 }
 
+// notificationNotCreatedDuration is the duration during which a Notification is checked to not be created
+var notificationNotCreatedDuration = 10 * time.Second
+
 // WaitForNotificationToBeNotCreated waits and checks that notification is NOT created.
-func (a *HostAwaitility) WaitForNotificationToNotBeCreated(t *testing.T, notificationName string) error {
-	t.Logf("waiting to check notification with name '%s' is NOT created", notificationName)
-	notification := &toolchainv1alpha1.Notification{}
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, 10*time.Second, true, func(ctx context.Context) (done bool, err error) {
-		notification = &toolchainv1alpha1.Notification{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: notificationName, Namespace: a.Namespace}, notification); err != nil {
-			if errors.IsNotFound(err) {
-				return false, nil
-			}
-			return false, err
-		}
-		return true, nil
-	})
-	if err == nil {
-		return fmt.Errorf("notification '%s' was found, but it was expected to not be created/present: \n %v", notificationName, notification)
-	}
-	if goerr.Is(err, context.DeadlineExceeded) {
-		return nil
-	}
-	return err
+func (a *HostAwaitility) WaitForNotificationToNotBeCreated(t testing.TB, notificationName string) error {
+	return For(t, a.Awaitility, &toolchainv1alpha1.Notification{}).NeverExists(notificationName, notificationNotCreatedDuration)
 }
 
 // WaitUntilNotificationsDeleted waits until the Notification for the given user is deleted (ie, not found)
-func (a *HostAwaitility) WaitUntilNotificationsDeleted(t *testing.T, username, notificationType string) error {
+func (a *HostAwaitility) WaitUntilNotificationsDeleted(t testing.TB, username, notificationType string) error {
 	t.Logf("waiting until notifications have been deleted for user '%s'", username)
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	return a.waitUntilWatched(t, &toolchainv1alpha1.Notification{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		labels := map[string]string{toolchainv1alpha1.NotificationUserNameLabelKey: username, toolchainv1alpha1.NotificationTypeLabelKey: notificationType}
 		opts := client.MatchingLabels(labels)
 		notificationList := &toolchainv1alpha1.NotificationList{}
-		if err := a.Client.List(context.TODO(), notificationList, opts); err != nil {
+		if err := a.Client.List(ctx, notificationList, opts); err != nil {
 			return false, err
 		}
 		return len(notificationList.Items) == 0, nil
@@ -1388,11 +1212,11 @@ func (a *HostAwaitility) WaitUntilNotificationsDeleted(t *testing.T, username, n
 }
 
 // WaitUntilNotificationWithNameDeleted waits until the Notification with the given name is deleted (ie, not found)
-func (a *HostAwaitility) WaitUntilNotificationWithNameDeleted(t *testing.T, notificationName string) error {
+func (a *HostAwaitility) WaitUntilNotificationWithNameDeleted(t testing.TB, notificationName string) error {
 	t.Logf("waiting for notification with name '%s' to get deleted", notificationName)
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	return a.waitUntilWatched(t, &toolchainv1alpha1.Notification{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		notification := &toolchainv1alpha1.Notification{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: notificationName, Namespace: a.Namespace}, notification); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Name: notificationName, Namespace: a.Namespace}, notification); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -1414,44 +1238,6 @@ func UntilNotificationHasConditions(expected ...toolchainv1alpha1.Condition) Not
 	}
 }
 
-// ToolchainStatusWaitCriterion a struct to compare with an expected ToolchainStatus
-type ToolchainStatusWaitCriterion struct {
-	Match func(*toolchainv1alpha1.ToolchainStatus) bool
-	Diff  func(*toolchainv1alpha1.ToolchainStatus) string
-}
-
-func matchToolchainStatusWaitCriterion(actual *toolchainv1alpha1.ToolchainStatus, criteria ...ToolchainStatusWaitCriterion) bool {
-	for _, c := range criteria {
-		if !c.Match(actual) {
-			return false
-		}
-	}
-	return true
-}
-
-func (a *HostAwaitility) printToolchainStatusWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.ToolchainStatus, criteria ...ToolchainStatusWaitCriterion) {
-	buf := &strings.Builder{}
-	if actual == nil {
-		buf.WriteString("failed to find Toolchainstatus\n")
-	} else {
-		buf.WriteString("failed to find ToolchainStatus with matching criteria:\n")
-		fmt.Fprintln(buf, "actual:")
-		y, _ := StringifyObject(actual)
-		fmt.Fprintln(buf, string(y))
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "diffs:")
-		for _, c := range criteria {
-			if !c.Match(actual) {
-				fmt.Fprintln(buf, c.Diff(actual))
-			}
-		}
-	}
-	// include also all ToolchainStatuses in the host namespace, to help troubleshooting
-	a.listAndPrint(t, "ToolchainStatuses", a.Namespace, &toolchainv1alpha1.ToolchainStatusList{})
-
-	t.Log(buf.String())
-}
-
 // UntilToolchainStatusHasConditions returns a `ToolchainStatusWaitCriterion` which checks that the given
 // ToolchainStatus has exactly all the given status conditions
 func UntilToolchainStatusHasConditions(expected ...toolchainv1alpha1.Condition) ToolchainStatusWaitCriterion {
@@ -1533,14 +1319,14 @@ func UntilProxyURLIsPresent(proxyURL string) ToolchainStatusWaitCriterion {
 }
 
 // WaitForToolchainStatus waits until the ToolchainStatus is available with the provided criteria, if any
-func (a *HostAwaitility) WaitForToolchainStatus(t *testing.T, criteria ...ToolchainStatusWaitCriterion) (*toolchainv1alpha1.ToolchainStatus, error) {
+func (a *HostAwaitility) WaitForToolchainStatus(t testing.TB, criteria ...ToolchainStatusWaitCriterion) (*toolchainv1alpha1.ToolchainStatus, error) {
 	// there should only be one toolchain status with the name toolchain-status
 	name := "toolchain-status"
 	toolchainStatus := &toolchainv1alpha1.ToolchainStatus{}
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, 2*a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.ToolchainStatus{}, a.Namespace, 2*a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &toolchainv1alpha1.ToolchainStatus{}
 		// retrieve the toolchainstatus from the host namespace
-		err = a.Client.Get(context.TODO(),
+		err = a.Client.Get(ctx,
 			types.NamespacedName{
 				Namespace: a.Namespace,
 				Name:      name,
@@ -1553,18 +1339,20 @@ func (a *HostAwaitility) WaitForToolchainStatus(t *testing.T, criteria ...Toolch
 			return false, err
 		}
 		toolchainStatus = obj
-		return matchToolchainStatusWaitCriterion(toolchainStatus, criteria...), nil
+		return matchWaitCriteria(toolchainStatus, criteria...), nil
 	})
 	// no match found, print the diffs
 	if err != nil {
-		a.printToolchainStatusWaitCriterionDiffs(t, toolchainStatus, criteria...)
+		// include also all ToolchainStatuses in the host namespace, to help troubleshooting
+		a.listAndPrint(t, "ToolchainStatuses", a.Namespace, &toolchainv1alpha1.ToolchainStatusList{})
+		printWaitCriteriaDiffs(t, "ToolchainStatus", toolchainStatus, criteria...)
 	}
 	return toolchainStatus, err
 }
 
-func (a *HostAwaitility) waitForResource(t *testing.T, namespace, name string, object client.Object) {
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
-		if err := a.Client.Get(context.TODO(), test.NamespacedName(namespace, name), object); err != nil {
+func (a *HostAwaitility) waitForResource(t testing.TB, namespace, name string, object client.Object) {
+	err := a.poll(t, a.Timeout, func(ctx context.Context) (done bool, err error) {
+		if err := a.Client.Get(ctx, test.NamespacedName(namespace, name), object); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -1575,7 +1363,7 @@ func (a *HostAwaitility) waitForResource(t *testing.T, namespace, name string, o
 	require.NoError(t, err)
 }
 
-func (a *HostAwaitility) WaitForToolchainClusterResources(t *testing.T) {
+func (a *HostAwaitility) WaitForToolchainClusterResources(t testing.TB) {
 	t.Logf("checking ToolchainCluster Resources")
 	actualSA := &corev1.ServiceAccount{}
 	a.waitForResource(t, a.Namespace, "toolchaincluster-host", actualSA)
@@ -1609,7 +1397,7 @@ func (a *HostAwaitility) WaitForToolchainClusterResources(t *testing.T) {
 }
 
 // GetToolchainConfig returns ToolchainConfig instance, nil if not found
-func (a *HostAwaitility) GetToolchainConfig(t *testing.T) *toolchainv1alpha1.ToolchainConfig {
+func (a *HostAwaitility) GetToolchainConfig(t testing.TB) *toolchainv1alpha1.ToolchainConfig {
 	config := &toolchainv1alpha1.ToolchainConfig{}
 	if err := a.Client.Get(context.TODO(), test.NamespacedName(a.Namespace, "config"), config); err != nil {
 		if errors.IsNotFound(err) {
@@ -1620,44 +1408,6 @@ func (a *HostAwaitility) GetToolchainConfig(t *testing.T) *toolchainv1alpha1.Too
 	return config
 }
 
-// ToolchainConfigWaitCriterion a struct to compare with an expected ToolchainConfig
-type ToolchainConfigWaitCriterion struct {
-	Match func(*toolchainv1alpha1.ToolchainConfig) bool
-	Diff  func(*toolchainv1alpha1.ToolchainConfig) string
-}
-
-func matchToolchainConfigWaitCriterion(actual *toolchainv1alpha1.ToolchainConfig, criteria ...ToolchainConfigWaitCriterion) bool {
-	for _, c := range criteria {
-		if !c.Match(actual) {
-			return false
-		}
-	}
-	return true
-}
-
-func (a *HostAwaitility) printToolchainConfigWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.ToolchainConfig, criteria ...ToolchainConfigWaitCriterion) {
-	buf := &strings.Builder{}
-	if actual == nil {
-		buf.WriteString("failed to find ToolchainConfig\n")
-	} else {
-		buf.WriteString("failed to find ToolchainConfig with matching criteria:\n")
-		fmt.Fprintln(buf, "actual:")
-		y, _ := StringifyObject(actual)
-		fmt.Fprintln(buf, string(y))
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "diffs:")
-		for _, c := range criteria {
-			if !c.Match(actual) {
-				fmt.Fprintln(buf, c.Diff(actual))
-			}
-		}
-	}
-	// include also all ToolchainConfigs in the host namespace, to help troubleshooting
-	a.listAndPrint(t, "ToolchainConfigs", a.Namespace, &toolchainv1alpha1.ToolchainConfigList{})
-
-	t.Log(buf.String())
-}
-
 func UntilToolchainConfigHasSyncedStatus(expected toolchainv1alpha1.Condition) ToolchainConfigWaitCriterion {
 	return ToolchainConfigWaitCriterion{
 		Match: func(actual *toolchainv1alpha1.ToolchainConfig) bool {
@@ -1698,14 +1448,14 @@ func UntilToolchainConfigHasVerificationEnabled(expected bool) ToolchainConfigWa
 }
 
 // WaitForToolchainConfig waits until the ToolchainConfig is available with the provided criteria, if any
-func (a *HostAwaitility) WaitForToolchainConfig(t *testing.T, criteria ...ToolchainConfigWaitCriterion) (*toolchainv1alpha1.ToolchainConfig, error) {
+func (a *HostAwaitility) WaitForToolchainConfig(t testing.TB, criteria ...ToolchainConfigWaitCriterion) (*toolchainv1alpha1.ToolchainConfig, error) {
 	// there should only be one ToolchainConfig with the name "config"
 	name := "config"
 	var toolchainConfig *toolchainv1alpha1.ToolchainConfig
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, 2*a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.ToolchainConfig{}, a.Namespace, 2*a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &toolchainv1alpha1.ToolchainConfig{}
 		// retrieve the ToolchainConfig from the host namespace
-		if err := a.Client.Get(context.TODO(),
+		if err := a.Client.Get(ctx,
 			types.NamespacedName{
 				Namespace: a.Namespace,
 				Name:      name,
@@ -1717,11 +1467,13 @@ func (a *HostAwaitility) WaitForToolchainConfig(t *testing.T, criteria ...Toolch
 			return false, err
 		}
 		toolchainConfig = obj
-		return matchToolchainConfigWaitCriterion(toolchainConfig, criteria...), nil
+		return matchWaitCriteria(toolchainConfig, criteria...), nil
 	})
 	// no match found, print the diffs
 	if err != nil {
-		a.printToolchainConfigWaitCriterionDiffs(t, toolchainConfig, criteria...)
+		// include also all ToolchainConfigs in the host namespace, to help troubleshooting
+		a.listAndPrint(t, "ToolchainConfigs", a.Namespace, &toolchainv1alpha1.ToolchainConfigList{})
+		printWaitCriteriaDiffs(t, "ToolchainConfig", toolchainConfig, criteria...)
 	}
 	return toolchainConfig, err
 }
@@ -1729,7 +1481,7 @@ func (a *HostAwaitility) WaitForToolchainConfig(t *testing.T, criteria ...Toolch
 // UpdateToolchainConfig updates the current resource of the ToolchainConfig CR with the given options.
 // If there is no existing resource already, then it creates a new one.
 // At the end of the test it returns the resource back to the original value/state.
-func (a *HostAwaitility) UpdateToolchainConfig(t *testing.T, options ...testconfig.ToolchainConfigOption) {
+func (a *HostAwaitility) UpdateToolchainConfig(t testing.TB, options ...testconfig.ToolchainConfigOption) {
 	var originalConfig *toolchainv1alpha1.ToolchainConfig
 	// try to get the current ToolchainConfig
 	config := a.GetToolchainConfig(t)
@@ -1792,11 +1544,11 @@ func (a *HostAwaitility) UpdateToolchainConfig(t *testing.T, options ...testconf
 // updateToolchainConfigWithRetry attempts to update the toolchainconfig, helpful because the toolchainconfig controller updates the toolchainconfig
 // resource periodically which can cause errors like `Operation cannot be fulfilled on toolchainconfigs.toolchain.dev.openshift.com "config": the object has been modified; please apply your changes to the latest version and try again`
 // in some cases. Retrying mitigates the potential for test flakiness due to this behaviour.
-func (a *HostAwaitility) updateToolchainConfigWithRetry(t *testing.T, updatedConfig *toolchainv1alpha1.ToolchainConfig) error {
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+func (a *HostAwaitility) updateToolchainConfigWithRetry(t testing.TB, updatedConfig *toolchainv1alpha1.ToolchainConfig) error {
+	err := a.poll(t, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		config := a.GetToolchainConfig(t)
 		config.Spec = updatedConfig.Spec
-		if err := a.Client.Update(context.TODO(), config); err != nil {
+		if err := a.Client.Update(ctx, config); err != nil {
 			t.Logf("Retrying ToolchainConfig update due to error: %s", err.Error())
 			return false, nil
 		}
@@ -1817,8 +1569,21 @@ func (a *HostAwaitility) GetHostOperatorPod() (corev1.Pod, error) {
 	return pods.Items[0], nil
 }
 
+// WaitForPodLogLine waits until the given pod logs a line matching the regular expression since the given time, and returns that line.
+// The logs of the host operator pod returned by GetHostOperatorPod are streamed when no pod is given.
+func (a *HostAwaitility) WaitForPodLogLine(t testing.TB, pod *corev1.Pod, regex *regexp.Regexp, since time.Time) (string, error) {
+	if pod == nil {
+		operatorPod, err := a.GetHostOperatorPod()
+		if err != nil {
+			return "", err
+		}
+		pod = &operatorPod
+	}
+	return a.waitForPodLogLine(t, *pod, regex, since)
+}
+
 // CreateAPIProxyConfig creates a config for the proxy API using the given user token
-func (a *HostAwaitility) CreateAPIProxyConfig(t *testing.T, usertoken, proxyURL string) *rest.Config {
+func (a *HostAwaitility) CreateAPIProxyConfig(t testing.TB, usertoken, proxyURL string) *rest.Config {
 	apiConfig, err := clientcmd.NewDefaultClientConfigLoadingRules().Load()
 	require.NoError(t, err)
 
@@ -1833,7 +1598,7 @@ func (a *HostAwaitility) CreateAPIProxyConfig(t *testing.T, usertoken, proxyURL
 }
 
 // CreateAPIProxyClient creates a client to the appstudio api proxy using the given user token
-func (a *HostAwaitility) CreateAPIProxyClient(t *testing.T, userToken, proxyURL string) (client.Client, error) {
+func (a *HostAwaitility) CreateAPIProxyClient(t testing.TB, userToken, proxyURL string) (client.Client, error) {
 	proxyKubeConfig := a.CreateAPIProxyConfig(t, userToken, proxyURL)
 
 	s := runtime.NewScheme()
@@ -1863,7 +1628,7 @@ func (a *HostAwaitility) CreateAPIProxyClient(t *testing.T, userToken, proxyURL
 	// updated yet and we try to create the client too quickly so retry to reduce flakiness.
 	var proxyCl client.Client
 	var initProxyClError error
-	waitErr := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	waitErr := a.poll(t, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		proxyCl, initProxyClError = client.New(proxyKubeConfig, client.Options{Scheme: s})
 		return initProxyClError == nil, nil
 	})
@@ -1881,28 +1646,14 @@ func (a *HostAwaitility) PluginProxyURLWithWorkspaceContext(proxyPluginName, wor
 	return fmt.Sprintf("%s/plugins/%s/workspaces/%s", a.APIProxyURL, proxyPluginName, workspaceContext)
 }
 
-type SpaceWaitCriterion struct {
-	Match func(*toolchainv1alpha1.Space) bool
-	Diff  func(*toolchainv1alpha1.Space) string
-}
-
-func matchSpaceWaitCriterion(actual *toolchainv1alpha1.Space, criteria ...SpaceWaitCriterion) bool {
-	for _, c := range criteria {
-		if !c.Match(actual) {
-			return false
-		}
-	}
-	return true
-}
-
 // WaitForSpace waits until the Space with the given name is available with the provided criteria, if any
-func (a *HostAwaitility) WaitForSpace(t *testing.T, name string, criteria ...SpaceWaitCriterion) (*toolchainv1alpha1.Space, error) {
+func (a *HostAwaitility) WaitForSpace(t testing.TB, name string, criteria ...SpaceWaitCriterion) (*toolchainv1alpha1.Space, error) {
 	t.Logf("waiting for Space '%s' with matching criteria", name)
 	var space *toolchainv1alpha1.Space
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, 2*a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.Space{}, a.Namespace, 2*a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &toolchainv1alpha1.Space{}
 		// retrieve the Space from the host namespace
-		if err := a.Client.Get(context.TODO(),
+		if err := a.Client.Get(ctx,
 			types.NamespacedName{
 				Namespace: a.Namespace,
 				Name:      name,
@@ -1914,21 +1665,23 @@ func (a *HostAwaitility) WaitForSpace(t *testing.T, name string, criteria ...Spa
 			return false, err
 		}
 		space = obj
-		return matchSpaceWaitCriterion(space, criteria...), nil
+		return matchWaitCriteria(space, criteria...), nil
 	})
 	// no match found, print the diffs
 	if err != nil {
-		a.printSpaceWaitCriterionDiffs(t, space, criteria...)
+		// also include Spaces resources in the host namespace, to help troubleshooting
+		a.listAndPrint(t, "Spaces", a.Namespace, &toolchainv1alpha1.SpaceList{})
+		printWaitCriteriaDiffs(t, "Space", space, criteria...)
 	}
 	return space, err
 }
 
-func (a *HostAwaitility) WaitForProxyPlugin(t *testing.T, name string) (*toolchainv1alpha1.ProxyPlugin, error) {
+func (a *HostAwaitility) WaitForProxyPlugin(t testing.TB, name string) (*toolchainv1alpha1.ProxyPlugin, error) {
 	t.Logf("waiting for ProxyPlugin %q", name)
 	var proxyPlugin *toolchainv1alpha1.ProxyPlugin
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, 2*a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.ProxyPlugin{}, a.Namespace, 2*a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &toolchainv1alpha1.ProxyPlugin{}
-		if err = a.Client.Get(context.TODO(),
+		if err = a.Client.Get(ctx,
 			types.NamespacedName{
 				Namespace: a.Namespace,
 				Name:      name,
@@ -1944,29 +1697,6 @@ func (a *HostAwaitility) WaitForProxyPlugin(t *testing.T, name string) (*toolcha
 	return proxyPlugin, err
 }
 
-func (a *HostAwaitility) printSpaceWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.Space, criteria ...SpaceWaitCriterion) {
-	buf := &strings.Builder{}
-	if actual == nil {
-		buf.WriteString("failed to find Space\n")
-	} else {
-		buf.WriteString("failed to find Space with matching criteria:\n")
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "actual:")
-		y, _ := StringifyObject(actual)
-		fmt.Fprintln(buf, string(y))
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "diffs:")
-		for _, c := range criteria {
-			if !c.Match(actual) {
-				fmt.Fprintln(buf, c.Diff(actual))
-			}
-		}
-	}
-	// also include Spaces resources in the host namespace, to help troubleshooting
-	a.listAndPrint(t, "Spaces", a.Namespace, &toolchainv1alpha1.SpaceList{})
-	t.Log(buf.String())
-}
-
 // UntilSpaceIsBeingDeleted checks if Space has Deletion Timestamp
 func UntilSpaceIsBeingDeleted() SpaceWaitCriterion {
 	return SpaceWaitCriterion{
@@ -2177,12 +1907,12 @@ func UntilSpaceHasStatusTargetCluster(expected string) SpaceWaitCriterion {
 }
 
 // WaitUntilSpaceAndSpaceBindingsDeleted waits until the Space with the given name and its associated SpaceBindings are deleted (ie, not found)
-func (a *HostAwaitility) WaitUntilSpaceAndSpaceBindingsDeleted(t *testing.T, name string) error {
+func (a *HostAwaitility) WaitUntilSpaceAndSpaceBindingsDeleted(t testing.TB, name string) error {
 	t.Logf("waiting until Space '%s' in namespace '%s' is deleted", name, a.Namespace)
 	var s *toolchainv1alpha1.Space
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntil(t, &toolchainv1alpha1.Space{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &toolchainv1alpha1.Space{}
-		if err := a.Client.Get(context.TODO(),
+		if err := a.Client.Get(ctx,
 			types.NamespacedName{
 				Namespace: a.Namespace,
 				Name:      name,
@@ -2209,9 +1939,9 @@ func (a *HostAwaitility) WaitUntilSpaceAndSpaceBindingsDeleted(t *testing.T, nam
 
 // WaitUntilSpaceBindingDeleted waits until the SpaceBinding with the given name is deleted (ie, not found)
 func (a *HostAwaitility) WaitUntilSpaceBindingDeleted(name string) error {
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	return a.waitUntilWatched(nil, &toolchainv1alpha1.SpaceBinding{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		mur := &toolchainv1alpha1.SpaceBinding{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, mur); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Namespace: a.Namespace, Name: name}, mur); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -2222,14 +1952,14 @@ func (a *HostAwaitility) WaitUntilSpaceBindingDeleted(name string) error {
 }
 
 // WaitUntilSpaceBindingsWithLabelDeleted waits until there are no SpaceBindings listed using the given labels
-func (a *HostAwaitility) WaitUntilSpaceBindingsWithLabelDeleted(t *testing.T, key, value string) error {
+func (a *HostAwaitility) WaitUntilSpaceBindingsWithLabelDeleted(t testing.TB, key, value string) error {
 	labels := map[string]string{key: value}
 	t.Logf("waiting until SpaceBindings with labels '%v' in namespace '%s' are deleted", labels, a.Namespace)
 	var spaceBindingList *toolchainv1alpha1.SpaceBindingList
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, 2*a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.SpaceBinding{}, a.Namespace, 2*a.Timeout, func(ctx context.Context) (done bool, err error) {
 		// retrieve the SpaceBinding from the host namespace
 		spaceBindingList = &toolchainv1alpha1.SpaceBindingList{}
-		if err = a.Client.List(context.TODO(), spaceBindingList, client.MatchingLabels(labels), client.InNamespace(a.Namespace)); err != nil {
+		if err = a.Client.List(ctx, spaceBindingList, client.MatchingLabels(labels), client.InNamespace(a.Namespace)); err != nil {
 			return false, err
 		}
 		return len(spaceBindingList.Items) == 0, nil
@@ -2246,22 +1976,8 @@ func (a *HostAwaitility) WaitUntilSpaceBindingsWithLabelDeleted(t *testing.T, ke
 	return err
 }
 
-type SpaceBindingWaitCriterion struct {
-	Match func(*toolchainv1alpha1.SpaceBinding) bool
-	Diff  func(*toolchainv1alpha1.SpaceBinding) string
-}
-
-func matchSpaceBindingWaitCriterion(actual *toolchainv1alpha1.SpaceBinding, criteria ...SpaceBindingWaitCriterion) bool {
-	for _, c := range criteria {
-		if !c.Match(actual) {
-			return false
-		}
-	}
-	return true
-}
-
 // WaitForSubSpace waits until the space provisioned by a SpaceRequest is available with the provided criteria, if any
-func (a *HostAwaitility) WaitForSubSpace(t *testing.T, spaceRequestName, spaceRequestNamespace, parentSpaceName string, criteria ...SpaceWaitCriterion) (*toolchainv1alpha1.Space, error) {
+func (a *HostAwaitility) WaitForSubSpace(t testing.TB, spaceRequestName, spaceRequestNamespace, parentSpaceName string, criteria ...SpaceWaitCriterion) (*toolchainv1alpha1.Space, error) {
 	var subSpace *toolchainv1alpha1.Space
 	labels := map[string]string{
 		toolchainv1alpha1.SpaceRequestLabelKey:          spaceRequestName,
@@ -2269,10 +1985,10 @@ func (a *HostAwaitility) WaitForSubSpace(t *testing.T, spaceRequestName, spaceRe
 		toolchainv1alpha1.ParentSpaceLabelKey:           parentSpaceName,
 	}
 
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, 2*a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.Space{}, a.Namespace, 2*a.Timeout, func(ctx context.Context) (done bool, err error) {
 		// retrieve the subSpace from the host namespace
 		spaceList := &toolchainv1alpha1.SpaceList{}
-		if err = a.Client.List(context.TODO(), spaceList, client.MatchingLabels(labels), client.InNamespace(a.Namespace)); err != nil {
+		if err = a.Client.List(ctx, spaceList, client.MatchingLabels(labels), client.InNamespace(a.Namespace)); err != nil {
 			return false, err
 		}
 		if len(spaceList.Items) == 0 {
@@ -2282,20 +1998,22 @@ func (a *HostAwaitility) WaitForSubSpace(t *testing.T, spaceRequestName, spaceRe
 			return false, fmt.Errorf("more than 1 subSpaces for SpaceRequest '%s'", spaceRequestName)
 		}
 		subSpace = &spaceList.Items[0]
-		return matchSpaceWaitCriterion(subSpace, criteria...), nil
+		return matchWaitCriteria(subSpace, criteria...), nil
 	})
 	// no match found, print the diffs
 	if err != nil {
-		a.printSpaceWaitCriterionDiffs(t, subSpace, criteria...)
+		// also include Spaces resources in the host namespace, to help troubleshooting
+		a.listAndPrint(t, "Spaces", a.Namespace, &toolchainv1alpha1.SpaceList{})
+		printWaitCriteriaDiffs(t, "Space", subSpace, criteria...)
 	}
 	return subSpace, err
 }
 
 // WaitForSpaceBinding waits until the SpaceBinding with the given MUR and Space names is available with the provided criteria, if any
-func (a *HostAwaitility) WaitForSpaceBinding(t *testing.T, murName, spaceName string, criteria ...SpaceBindingWaitCriterion) (*toolchainv1alpha1.SpaceBinding, error) {
+func (a *HostAwaitility) WaitForSpaceBinding(t testing.TB, murName, spaceName string, criteria ...SpaceBindingWaitCriterion) (*toolchainv1alpha1.SpaceBinding, error) {
 	var spaceBinding *toolchainv1alpha1.SpaceBinding
 
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, 2*a.Timeout, true, func(ctx context.Context) (bool, error) {
+	err := a.poll(t, 2*a.Timeout, func(ctx context.Context) (bool, error) {
 		// retrieve the SpaceBinding from the host namespace
 		var err error
 		if spaceBinding, err = a.GetSpaceBindingByListing(murName, spaceName); err != nil {
@@ -2304,11 +2022,13 @@ func (a *HostAwaitility) WaitForSpaceBinding(t *testing.T, murName, spaceName st
 		if spaceBinding == nil {
 			return false, nil
 		}
-		return matchSpaceBindingWaitCriterion(spaceBinding, criteria...), nil
+		return matchWaitCriteria(spaceBinding, criteria...), nil
 	})
 	// no match found, print the diffs
 	if err != nil {
-		a.printSpaceBindingWaitCriterionDiffs(t, spaceBinding, criteria...)
+		// also include SpaceBindings resources in the host namespace, to help troubleshooting
+		a.listAndPrint(t, "SpaceBindings", a.Namespace, &toolchainv1alpha1.SpaceBindingList{})
+		printWaitCriteriaDiffs(t, "SpaceBinding", spaceBinding, criteria...)
 	}
 	return spaceBinding, err
 }
@@ -2333,29 +2053,6 @@ func (a *HostAwaitility) GetSpaceBindingByListing(murName, spaceName string) (*t
 	return &spaceBindingList.Items[0], nil
 }
 
-func (a *HostAwaitility) printSpaceBindingWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.SpaceBinding, criteria ...SpaceBindingWaitCriterion) {
-	buf := &strings.Builder{}
-	if actual == nil {
-		buf.WriteString("failed to find SpaceBinding\n")
-	} else {
-		buf.WriteString("failed to find SpaceBinding with matching criteria:\n")
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "actual:")
-		y, _ := StringifyObject(actual)
-		fmt.Fprintln(buf, string(y))
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "diffs:")
-		for _, c := range criteria {
-			if !c.Match(actual) {
-				fmt.Fprintln(buf, c.Diff(actual))
-			}
-		}
-	}
-	// also include SpaceBindings resources in the host namespace, to help troubleshooting
-	a.listAndPrint(t, "SpaceBindings", a.Namespace, &toolchainv1alpha1.SpaceBindingList{})
-	t.Log(buf.String())
-}
-
 func (a *HostAwaitility) ListSpaceBindings(spaceName string) ([]toolchainv1alpha1.SpaceBinding, error) {
 	bindings := &toolchainv1alpha1.SpaceBindingList{}
 	if err := a.Client.List(context.TODO(), bindings, client.InNamespace(a.Namespace), client.MatchingLabels{
@@ -2447,27 +2144,13 @@ func UntilSpaceBindingHasLabel(key, value string) SpaceBindingWaitCriterion {
 	}
 }
 
-type SocialEventWaitCriterion struct {
-	Match func(*toolchainv1alpha1.SocialEvent) bool
-	Diff  func(*toolchainv1alpha1.SocialEvent) string
-}
-
-func matchSocialEventWaitCriterion(actual *toolchainv1alpha1.SocialEvent, criteria ...SocialEventWaitCriterion) bool {
-	for _, c := range criteria {
-		if !c.Match(actual) {
-			return false
-		}
-	}
-	return true
-}
-
-func (a *HostAwaitility) WaitForSocialEvent(t *testing.T, name string, criteria ...SocialEventWaitCriterion) (*toolchainv1alpha1.SocialEvent, error) {
+func (a *HostAwaitility) WaitForSocialEvent(t testing.TB, name string, criteria ...SocialEventWaitCriterion) (*toolchainv1alpha1.SocialEvent, error) {
 	t.Logf("waiting for SocialEvent '%s' in namespace '%s' to match criteria", name, a.Namespace)
 	var event *toolchainv1alpha1.SocialEvent
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, 2*a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.SocialEvent{}, a.Namespace, 2*a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &toolchainv1alpha1.SocialEvent{}
 		// retrieve the Space from the host namespace
-		if err := a.Client.Get(context.TODO(),
+		if err := a.Client.Get(ctx,
 			types.NamespacedName{
 				Namespace: a.Namespace,
 				Name:      name,
@@ -2479,11 +2162,13 @@ func (a *HostAwaitility) WaitForSocialEvent(t *testing.T, name string, criteria
 			return false, err
 		}
 		event = obj
-		return matchSocialEventWaitCriterion(event, criteria...), nil
+		return matchWaitCriteria(event, criteria...), nil
 	})
 	// no match found, print the diffs
 	if err != nil {
-		a.printSocialEventWaitCriterionDiffs(t, event, criteria...)
+		// also include SocialEvents resources in the host namespace, to help troubleshooting
+		a.listAndPrint(t, "SocialEvents", a.Namespace, &toolchainv1alpha1.SocialEventList{})
+		printWaitCriteriaDiffs(t, "SocialEvent", event, criteria...)
 	}
 	return event, err
 }
@@ -2514,29 +2199,6 @@ func UntilSocialEventHasConditions(expected ...toolchainv1alpha1.Condition) Soci
 	}
 }
 
-func (a *HostAwaitility) printSocialEventWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.SocialEvent, criteria ...SocialEventWaitCriterion) {
-	buf := &strings.Builder{}
-	if actual == nil {
-		fmt.Fprintln(buf, "failed to find SocialEvent")
-	} else {
-		fmt.Fprintln(buf, "failed to find SocialEvent with matching criteria:")
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "actual:")
-		y, _ := StringifyObject(actual)
-		fmt.Fprintln(buf, string(y))
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "diffs:")
-		for _, c := range criteria {
-			if !c.Match(actual) {
-				fmt.Fprintln(buf, c.Diff(actual))
-			}
-		}
-	}
-	// also include SocialEvents resources in the host namespace, to help troubleshooting
-	a.listAndPrint(t, "SocialEvents", a.Namespace, &toolchainv1alpha1.SocialEventList{})
-	t.Log(buf.String())
-}
-
 const (
 	DNS1123NameMaximumLength         = 63
 	DNS1123NotAllowedCharacters      = "[^-a-z0-9]"
@@ -2570,14 +2232,14 @@ func EncodeUserIdentifier(subject string) string {
 
 // CreateSpaceAndSpaceBinding creates a space and spacebindig and waits until both are present.
 // We are creating both of them (Space and SpaceBinding) at the same time , with polling logic, so that we mitigate the issue with spacecleanup_controller deleting the Space before we create it's SpaceBinding.
-func (a *HostAwaitility) CreateSpaceAndSpaceBinding(t *testing.T, mur *toolchainv1alpha1.MasterUserRecord, space *toolchainv1alpha1.Space, spaceRole string) (*toolchainv1alpha1.Space, *toolchainv1alpha1.SpaceBinding, error) {
+func (a *HostAwaitility) CreateSpaceAndSpaceBinding(t testing.TB, mur *toolchainv1alpha1.MasterUserRecord, space *toolchainv1alpha1.Space, spaceRole string) (*toolchainv1alpha1.Space, *toolchainv1alpha1.SpaceBinding, error) {
 	var spaceBinding *toolchainv1alpha1.SpaceBinding
 	var spaceCreated *toolchainv1alpha1.Space
 	testutil.LogWithTimestamp(t, fmt.Sprintf("Creating Space %s (prefix: %s) and SpaceBinding with role %s for %s", space.Name, space.GenerateName, spaceRole, mur.Name))
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.poll(t, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		// create the space
 		spaceToCreate := space.DeepCopy()
-		if err := a.Create(spaceToCreate); err != nil {
+		if err := a.Create(t, spaceToCreate); err != nil {
 			if !errors.IsAlreadyExists(err) {
 				return false, err
 			}
@@ -2588,7 +2250,7 @@ func (a *HostAwaitility) CreateSpaceAndSpaceBinding(t *testing.T, mur *toolchain
 
 		// create spacebinding request immediately after ...
 		spaceBindingToCreate := spacebinding.NewSpaceBinding(mur, spaceToCreate, spaceRole, spacebinding.WithRole(spaceRole))
-		if err := a.Create(spaceBindingToCreate); err != nil {
+		if err := a.Create(t, spaceBindingToCreate); err != nil {
 			if !errors.IsAlreadyExists(err) {
 				return false, err
 			}
@@ -2596,7 +2258,7 @@ func (a *HostAwaitility) CreateSpaceAndSpaceBinding(t *testing.T, mur *toolchain
 
 		// let's see if space was provisioned as expected
 		spaceCreated = &toolchainv1alpha1.Space{}
-		err = a.Client.Get(context.TODO(), client.ObjectKeyFromObject(spaceToCreate), spaceCreated)
+		err = a.Client.Get(ctx, client.ObjectKeyFromObject(spaceToCreate), spaceCreated)
 		if err != nil {
 			if errors.IsNotFound(err) {
 				testutil.LogWithTimestamp(t, fmt.Sprintf("The created Space %s is not present in namespace %s", spaceToCreate.Name, spaceToCreate.Namespace))
@@ -2628,6 +2290,7 @@ func (a *HostAwaitility) CreateSpaceAndSpaceBinding(t *testing.T, mur *toolchain
 		// schedules the cleanup of the Space and the SpaceBinding at the end of the current test
 		cleanup.AddCleanTasks(t, a.GetClient(), spaceCreated)
 		cleanup.AddCleanTasks(t, a.GetClient(), spaceBinding)
+		a.Track(t, spaceCreated, spaceBinding)
 		return true, nil
 	})
 	return spaceCreated, spaceBinding, err
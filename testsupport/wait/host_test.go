@@ -0,0 +1,102 @@
+package wait
+
+import (
+	"testing"
+	"time"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestWaitAndVerifyThatUserSignupIsNotCreated(t *testing.T) {
+	t.Run("not created", func(t *testing.T) {
+		// given
+		hostAwait := &HostAwaitility{Awaitility: newTestAwaitility(t, interceptor.Funcs{})}
+
+		// when
+		failures := recordFailures(t, func(t testing.TB) {
+			hostAwait.WaitAndVerifyThatUserSignupIsNotCreated(t, "john")
+		})
+
+		// then
+		assert.Empty(t, failures)
+	})
+
+	t.Run("created", func(t *testing.T) {
+		// given
+		hostAwait := &HostAwaitility{Awaitility: newTestAwaitility(t, interceptor.Funcs{},
+			&toolchainv1alpha1.UserSignup{ObjectMeta: metav1.ObjectMeta{Namespace: "operator", Name: "john"}})}
+		hostAwait.Timeout = time.Minute
+		start := time.Now()
+
+		// when
+		failures := recordFailures(t, func(t testing.TB) {
+			hostAwait.WaitAndVerifyThatUserSignupIsNotCreated(t, "john")
+		})
+
+		// then
+		require.Len(t, failures, 1)
+		assert.Contains(t, failures[0], "object operator/john exists in the cluster")
+		assert.Contains(t, failures[0], "UserSignup 'john' should not be created")
+		assert.Less(t, time.Since(start), time.Minute)
+	})
+
+	t.Run("unable to verify", func(t *testing.T) {
+		// given
+		hostAwait := &HostAwaitility{Awaitility: newTestAwaitility(t, interceptor.Funcs{Get: failingGet})}
+
+		// when
+		failures := recordFailures(t, func(t testing.TB) {
+			hostAwait.WaitAndVerifyThatUserSignupIsNotCreated(t, "john")
+		})
+
+		// then
+		require.Len(t, failures, 1)
+		assert.Contains(t, failures[0], errUnavailable.Error())
+	})
+}
+
+func TestWaitForNotificationToNotBeCreated(t *testing.T) {
+	defer func(duration time.Duration) {
+		notificationNotCreatedDuration = duration
+	}(notificationNotCreatedDuration)
+	notificationNotCreatedDuration = 50 * time.Millisecond
+
+	t.Run("not created", func(t *testing.T) {
+		// given
+		hostAwait := &HostAwaitility{Awaitility: newTestAwaitility(t, interceptor.Funcs{})}
+
+		// when
+		err := hostAwait.WaitForNotificationToNotBeCreated(t, "john-deactivated")
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("created", func(t *testing.T) {
+		// given
+		hostAwait := &HostAwaitility{Awaitility: newTestAwaitility(t, interceptor.Funcs{},
+			&toolchainv1alpha1.Notification{ObjectMeta: metav1.ObjectMeta{Namespace: "operator", Name: "john-deactivated"}})}
+
+		// when
+		err := hostAwait.WaitForNotificationToNotBeCreated(t, "john-deactivated")
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "object operator/john-deactivated exists in the cluster")
+	})
+
+	t.Run("unable to verify", func(t *testing.T) {
+		// given
+		hostAwait := &HostAwaitility{Awaitility: newTestAwaitility(t, interceptor.Funcs{Get: failingGet})}
+
+		// when
+		err := hostAwait.WaitForNotificationToNotBeCreated(t, "john-deactivated")
+
+		// then
+		require.ErrorIs(t, err, errUnavailable)
+	})
+}
@@ -0,0 +1,223 @@
+// waitersgen generates the typed waiters, criteria and deletion waits of the toolchain kinds in the `wait` package.
+//
+// For each kind of the toolchain API, it generates:
+// - the `<Kind>WaitCriterion` type (an alias of `WaitCriterion[*<Kind>]`),
+// - the `For<Kind>` function of the Awaitility, which returns a `TypedWaiter` to wait for an object with criteria and print
+// the diffs when it doesn't match, or to wait for its deletion,
+// - the `Until<Kind>IsBeingDeleted`, `Until<Kind>HasLabel` and (if the kind has status conditions) `Until<Kind>HasConditions` criteria.
+//
+// The declarations which already exist in the package are not generated, so that they can be customized. The kinds with a
+// `<Kind>WaitCriterion` type declared by hand (with another shape) are skipped.
+//
+// Usage (from the directory of the `wait` package): go run ./internal/waitersgen -output zz_generated.waiters.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type kind struct {
+	Name string
+	// Criterion is true if the `<Kind>WaitCriterion` type must be generated
+	Criterion      bool
+	For            bool
+	IsBeingDeleted bool
+	HasLabel       bool
+	HasConditions  bool
+}
+
+func main() {
+	output := flag.String("output", "zz_generated.waiters.go", "the file to generate")
+	flag.Parse()
+
+	declared, err := declarations(".", *output)
+	if err != nil {
+		log.Fatalf("unable to parse the package: %s", err)
+	}
+	kinds, err := toolchainKinds()
+	if err != nil {
+		log.Fatalf("unable to list the toolchain kinds: %s", err)
+	}
+
+	var generated []kind
+	for name, typ := range kinds {
+		if declared[name+"WaitCriterion"] {
+			log.Printf("skipping the %s kind: its %sWaitCriterion type is declared by hand", name, name)
+			continue
+		}
+		generated = append(generated, kind{
+			Name:           name,
+			Criterion:      true,
+			For:            !declared["Awaitility.For"+name],
+			IsBeingDeleted: !declared["Until"+name+"IsBeingDeleted"],
+			HasLabel:       !declared["Until"+name+"HasLabel"],
+			HasConditions:  !declared["Until"+name+"HasConditions"] && hasConditions(typ),
+		})
+	}
+	sort.Slice(generated, func(i, j int) bool {
+		return generated[i].Name < generated[j].Name
+	})
+
+	buf := &bytes.Buffer{}
+	if err := waiters.Execute(buf, generated); err != nil {
+		log.Fatalf("unable to generate the waiters: %s", err)
+	}
+	content, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("unable to format the generated waiters: %s\n%s", err, buf.String())
+	}
+	if err := os.WriteFile(*output, content, 0o600); err != nil {
+		log.Fatalf("unable to write the generated waiters: %s", err)
+	}
+}
+
+// toolchainKinds returns the types of the kinds of the toolchain API which have a list type, by name
+func toolchainKinds() (map[string]reflect.Type, error) {
+	scheme := runtime.NewScheme()
+	if err := toolchainv1alpha1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	apiPackage := reflect.TypeOf(toolchainv1alpha1.Condition{}).PkgPath()
+	known := scheme.KnownTypes(toolchainv1alpha1.GroupVersion)
+	kinds := map[string]reflect.Type{}
+	for name, typ := range known {
+		if typ.PkgPath() != apiPackage || strings.HasSuffix(name, "List") {
+			continue
+		}
+		if _, ok := known[name+"List"]; ok {
+			kinds[name] = typ
+		}
+	}
+	return kinds, nil
+}
+
+// hasConditions returns true if the type has a `Status.Conditions` field with the toolchain conditions
+func hasConditions(typ reflect.Type) bool {
+	status, ok := typ.FieldByName("Status")
+	if !ok || status.Type.Kind() != reflect.Struct {
+		return false
+	}
+	conditions, ok := status.Type.FieldByName("Conditions")
+	return ok && conditions.Type == reflect.TypeOf([]toolchainv1alpha1.Condition{})
+}
+
+// declarations returns the names of the types, functions and methods (as `<Receiver>.<Method>`) declared in the package
+// in the given directory, except in the test files and in the generated file
+func declarations(dir, output string) (map[string]bool, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != filepath.Base(output)
+	}, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	declared := map[string]bool{}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				switch d := decl.(type) {
+				case *ast.GenDecl:
+					for _, spec := range d.Specs {
+						if ts, ok := spec.(*ast.TypeSpec); ok {
+							declared[ts.Name.Name] = true
+						}
+					}
+				case *ast.FuncDecl:
+					if d.Recv == nil {
+						declared[d.Name.Name] = true
+						continue
+					}
+					recv := d.Recv.List[0].Type
+					if star, ok := recv.(*ast.StarExpr); ok {
+						recv = star.X
+					}
+					if ident, ok := recv.(*ast.Ident); ok {
+						declared[fmt.Sprintf("%s.%s", ident.Name, d.Name.Name)] = true
+					}
+				}
+			}
+		}
+	}
+	return declared, nil
+}
+
+var waiters = template.Must(template.New("waiters").Parse(`// Code generated by waitersgen. DO NOT EDIT.
+
+package wait
+
+import (
+	"fmt"
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+)
+{{ range . }}
+{{- if .Criterion }}
+// {{ .Name }}WaitCriterion a struct to compare with an expected {{ .Name }}
+type {{ .Name }}WaitCriterion = WaitCriterion[*toolchainv1alpha1.{{ .Name }}]
+{{ end }}
+{{- if .For }}
+// For{{ .Name }} returns a waiter for the objects of kind {{ .Name }} in the namespace of the awaitility
+func (a *Awaitility) For{{ .Name }}(t testing.TB) *TypedWaiter[*toolchainv1alpha1.{{ .Name }}] {
+	return newTypedWaiter(t, a, "{{ .Name }}", func() *toolchainv1alpha1.{{ .Name }} {
+		return &toolchainv1alpha1.{{ .Name }}{}
+	})
+}
+{{ end }}
+{{- if .IsBeingDeleted }}
+// Until{{ .Name }}IsBeingDeleted checks that the {{ .Name }} has a deletion timestamp
+func Until{{ .Name }}IsBeingDeleted() {{ .Name }}WaitCriterion {
+	return {{ .Name }}WaitCriterion{
+		Match: func(actual *toolchainv1alpha1.{{ .Name }}) bool {
+			return actual.DeletionTimestamp != nil
+		},
+		Diff: func(_ *toolchainv1alpha1.{{ .Name }}) string {
+			return "expected a deletion timestamp"
+		},
+	}
+}
+{{ end }}
+{{- if .HasLabel }}
+// Until{{ .Name }}HasLabel checks that the {{ .Name }} has the given label
+func Until{{ .Name }}HasLabel(key, value string) {{ .Name }}WaitCriterion {
+	return {{ .Name }}WaitCriterion{
+		Match: func(actual *toolchainv1alpha1.{{ .Name }}) bool {
+			return actual.Labels != nil && actual.Labels[key] == value
+		},
+		Diff: func(actual *toolchainv1alpha1.{{ .Name }}) string {
+			return fmt.Sprintf("expected label '%s' to have value '%s'\nactual labels: %v", key, value, actual.Labels)
+		},
+	}
+}
+{{ end }}
+{{- if .HasConditions }}
+// Until{{ .Name }}HasConditions checks that the {{ .Name }} has exactly all the given status conditions
+func Until{{ .Name }}HasConditions(expected ...toolchainv1alpha1.Condition) {{ .Name }}WaitCriterion {
+	return {{ .Name }}WaitCriterion{
+		Match: func(actual *toolchainv1alpha1.{{ .Name }}) bool {
+			return test.ConditionsMatch(actual.Status.Conditions, expected...)
+		},
+		Diff: func(actual *toolchainv1alpha1.{{ .Name }}) string {
+			return fmt.Sprintf("expected conditions to match:\n%s", Diff(expected, actual.Status.Conditions))
+		},
+	}
+}
+{{ end }}
+{{- end }}`))
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToolchainKinds(t *testing.T) {
+	// when
+	kinds, err := toolchainKinds()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, reflect.TypeOf(toolchainv1alpha1.Space{}), kinds["Space"])
+	assert.Contains(t, kinds, "UserSignup")
+	assert.NotContains(t, kinds, "SpaceList")
+	assert.NotContains(t, kinds, "WatchEvent")
+}
+
+func TestHasConditions(t *testing.T) {
+	assert.True(t, hasConditions(reflect.TypeOf(toolchainv1alpha1.UserSignup{})))
+	assert.False(t, hasConditions(reflect.TypeOf(toolchainv1alpha1.TierTemplate{})))
+}
+
+func TestDeclarations(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "host.go"), []byte(`package wait
+
+type SpaceWaitCriterion struct{}
+
+type Awaitility struct{}
+
+func (a *Awaitility) ForSpace() {}
+
+func UntilSpaceHasLabel() {}
+`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "zz_generated.waiters.go"), []byte(`package wait
+
+func UntilSpaceIsBeingDeleted() {}
+`), 0o600))
+
+	// when
+	declared, err := declarations(dir, "zz_generated.waiters.go")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{
+		"SpaceWaitCriterion":  true,
+		"Awaitility":          true,
+		"Awaitility.ForSpace": true,
+		"UntilSpaceHasLabel":  true,
+	}, declared)
+}
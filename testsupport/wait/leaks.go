@@ -0,0 +1,213 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/cleanup"
+	quotav1 "github.com/openshift/api/quota/v1"
+	userv1 "github.com/openshift/api/user/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// LeakThresholdVar is the name of the env var with the number of objects which can be leaked by the tests of a package before it
+// fails (0 by default)
+const LeakThresholdVar = "LEAK_THRESHOLD"
+
+// leakCheckInterval is the interval at which the objects are listed until the ones created by the tests are deleted, which is
+// longer than the retry interval of the waits since all the objects of the checked kinds are listed
+const leakCheckInterval = 5 * time.Second
+
+// leakIgnoredKinds are the toolchain kinds whose objects are created and deleted by the operators on their own schedule, regardless
+// of the objects created by the tests
+var leakIgnoredKinds = map[string]bool{
+	"Notification":         true,
+	"TierTemplateRevision": true,
+}
+
+// leakCheckedKind is a kind of objects checked for leaks, along with the options to list the relevant objects
+type leakCheckedKind struct {
+	gvk  schema.GroupVersionKind
+	opts []client.ListOption
+}
+
+// leakCheckedKinds returns the toolchain kinds, the user namespaces, the Identities, the Users and the ClusterResourceQuotas
+func leakCheckedKinds(a *Awaitility) []leakCheckedKind {
+	var kinds []leakCheckedKind
+	known := a.Client.Scheme().KnownTypes(toolchainv1alpha1.GroupVersion)
+	for name := range known {
+		if strings.HasSuffix(name, "List") || leakIgnoredKinds[name] {
+			continue
+		}
+		if _, ok := known[name+"List"]; ok {
+			kinds = append(kinds, leakCheckedKind{gvk: toolchainv1alpha1.GroupVersion.WithKind(name)})
+		}
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		return kinds[i].gvk.Kind < kinds[j].gvk.Kind
+	})
+	return append(kinds,
+		leakCheckedKind{gvk: corev1.SchemeGroupVersion.WithKind("Namespace"), opts: []client.ListOption{client.HasLabels{toolchainv1alpha1.TypeLabelKey}}},
+		leakCheckedKind{gvk: userv1.GroupVersion.WithKind("Identity")},
+		leakCheckedKind{gvk: userv1.GroupVersion.WithKind("User")},
+		leakCheckedKind{gvk: quotav1.GroupVersion.WithKind("ClusterResourceQuota")},
+	)
+}
+
+type snapshotKey struct {
+	cluster   string
+	kind      string
+	namespace string
+	name      string
+}
+
+// ObjectsSnapshot contains the objects of the kinds checked for leaks in the host and member clusters, at a given time
+type ObjectsSnapshot struct {
+	objects map[snapshotKey]metav1.PartialObjectMetadata
+}
+
+// TakeObjectsSnapshot lists the toolchain objects, the user namespaces, the Identities, the Users and the ClusterResourceQuotas
+// of the host and member clusters, so that the objects leaked by the tests can be found afterwards (see FindLeaks)
+func TakeObjectsSnapshot(awaitilities Awaitilities) (*ObjectsSnapshot, error) {
+	snapshot := &ObjectsSnapshot{
+		objects: map[snapshotKey]metav1.PartialObjectMetadata{},
+	}
+	for _, a := range distinctClusters(awaitilities) {
+		for _, kind := range leakCheckedKinds(a) {
+			list := &metav1.PartialObjectMetadataList{}
+			list.SetGroupVersionKind(kind.gvk.GroupVersion().WithKind(kind.gvk.Kind + "List"))
+			if err := a.Client.List(context.TODO(), list, kind.opts...); err != nil {
+				return nil, fmt.Errorf("failed to list the %s objects in the %s cluster: %w", kind.gvk.Kind, a.ClusterName, err)
+			}
+			for _, obj := range list.Items {
+				key := snapshotKey{cluster: a.ClusterName, kind: kind.gvk.Kind, namespace: obj.Namespace, name: obj.Name}
+				snapshot.objects[key] = obj
+			}
+		}
+	}
+	return snapshot, nil
+}
+
+// Counts returns the number of objects in the snapshot, by kind
+func (s *ObjectsSnapshot) Counts() map[string]int {
+	counts := map[string]int{}
+	for key := range s.objects {
+		counts[key.kind]++
+	}
+	return counts
+}
+
+// Leak is an object which was created after the baseline snapshot was taken, and which was not deleted
+type Leak struct {
+	Cluster   string
+	Kind      string
+	Namespace string
+	Name      string
+	// Test is the name of the test which created the object, if it is known
+	Test string
+}
+
+// FindLeaks waits until the objects which didn't exist when the baseline snapshot was taken are deleted (or being deleted), and
+// returns the ones which still exist after the timeout, along with the tests which created them. The objects of the tests whose
+// objects are retained by the cleanup policy (see cleanup.RetainedTests) are not leaked.
+func FindLeaks(awaitilities Awaitilities, baseline *ObjectsSnapshot, timeout time.Duration) ([]Leak, error) {
+	var leaks []Leak
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	registrations, retained := cleanup.Registrations(), cleanup.RetainedTests()
+	err := wait.PollUntilContextCancel(ctx, leakCheckInterval, true, func(_ context.Context) (bool, error) {
+		current, err := TakeObjectsSnapshot(awaitilities)
+		if err != nil {
+			return false, err
+		}
+		leaks = baseline.leaksIn(current, registrations, retained)
+		return len(leaks) == 0, nil
+	})
+	if err != nil && ctx.Err() == nil {
+		return nil, err
+	}
+	return leaks, nil
+}
+
+// leaksIn returns the objects of the given snapshot which are not in this one, which are not being deleted and which were not
+// created by one of the given tests whose objects are retained
+func (s *ObjectsSnapshot) leaksIn(current *ObjectsSnapshot, registrations []cleanup.Registration, retained []string) []Leak {
+	var leaks []Leak
+	for key, obj := range current.objects {
+		if _, existed := s.objects[key]; existed || obj.DeletionTimestamp != nil {
+			continue
+		}
+		test := creatorOf(key.kind, obj, registrations)
+		if test != "" && slices.Contains(retained, test) {
+			continue
+		}
+		leaks = append(leaks, Leak{
+			Cluster:   key.cluster,
+			Kind:      key.kind,
+			Namespace: key.namespace,
+			Name:      key.name,
+			Test:      test,
+		})
+	}
+	sort.Slice(leaks, func(i, j int) bool {
+		return fmt.Sprint(leaks[i]) < fmt.Sprint(leaks[j])
+	})
+	return leaks
+}
+
+// creatorOf returns the name of the test which created the object: the test which registered it for cleanup, or else the test in
+// its annotation (see StampRun), or else the test which registered the UserSignup, the MasterUserRecord or the Space which owns it
+func creatorOf(kind string, obj metav1.PartialObjectMetadata, registrations []cleanup.Registration) string {
+	for _, r := range registrations {
+		if cleanup.KindOf(r.Object) == kind && r.Object.GetNamespace() == obj.Namespace && r.Object.GetName() == obj.Name {
+			return r.Test
+		}
+	}
+	if test := obj.Annotations[TestNameAnnotationKey]; test != "" {
+		return test
+	}
+	// the objects created by the operators are labelled with the name of their Space or owner, or named after them
+	for _, owner := range []string{obj.Labels[toolchainv1alpha1.SpaceLabelKey], obj.Labels[toolchainv1alpha1.OwnerLabelKey], obj.Name} {
+		if owner == "" {
+			continue
+		}
+		for _, r := range registrations {
+			switch o := r.Object.(type) {
+			case *toolchainv1alpha1.UserSignup:
+				if o.Name == owner || o.Status.CompliantUsername == owner {
+					return r.Test
+				}
+			case *toolchainv1alpha1.MasterUserRecord, *toolchainv1alpha1.Space:
+				if o.GetName() == owner {
+					return r.Test
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// PrintLeaks prints the table of the leaked objects
+func PrintLeaks(out io.Writer, leaks []Leak) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CLUSTER\tKIND\tNAMESPACE\tNAME\tTEST")
+	for _, l := range leaks {
+		test := l.Test
+		if test == "" {
+			test = "<unknown>"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", l.Cluster, l.Kind, l.Namespace, l.Name, test)
+	}
+	_ = w.Flush()
+}
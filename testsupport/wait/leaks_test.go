@@ -0,0 +1,81 @@
+package wait
+
+import (
+	"bytes"
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-e2e/testsupport/cleanup"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLeaksIn(t *testing.T) {
+	// given
+	object := func(name string, labels, annotations map[string]string) metav1.PartialObjectMetadata {
+		return metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "host", Labels: labels, Annotations: annotations}}
+	}
+	key := func(kind, name string) snapshotKey {
+		return snapshotKey{cluster: "host-cluster", kind: kind, namespace: "host", name: name}
+	}
+	baseline := &ObjectsSnapshot{objects: map[snapshotKey]metav1.PartialObjectMetadata{
+		key("Space", "existing"): object("existing", nil, nil),
+	}}
+	deleting := object("deleting", nil, nil)
+	deleting.DeletionTimestamp = &metav1.Time{}
+	current := &ObjectsSnapshot{objects: map[snapshotKey]metav1.PartialObjectMetadata{
+		key("Space", "existing"):          object("existing", nil, nil),
+		key("Space", "deleting"):          deleting,
+		key("SocialEvent", "registered"):  object("registered", nil, nil),
+		key("SocialEvent", "annotated"):   object("annotated", nil, map[string]string{TestNameAnnotationKey: "TestAnnotated"}),
+		key("Namespace", "john-dev"):      object("john-dev", map[string]string{toolchainv1alpha1.SpaceLabelKey: "john"}, nil),
+		key("MasterUserRecord", "john"):   object("john", map[string]string{toolchainv1alpha1.OwnerLabelKey: "john-signup"}, nil),
+		key("ProxyPlugin", "unknown"):     object("unknown", nil, nil),
+		key("SocialEvent", "other-event"): object("other-event", nil, nil),
+		key("SocialEvent", "retained"):    object("retained", nil, nil),
+		key("Namespace", "jane-dev"):      object("jane-dev", map[string]string{toolchainv1alpha1.SpaceLabelKey: "jane"}, nil),
+	}}
+	registrations := []cleanup.Registration{
+		{Object: &toolchainv1alpha1.SocialEvent{ObjectMeta: metav1.ObjectMeta{Name: "registered", Namespace: "host"}}, Test: "TestRegistered"},
+		{
+			Object: &toolchainv1alpha1.UserSignup{
+				ObjectMeta: metav1.ObjectMeta{Name: "john-signup", Namespace: "host"},
+				Status:     toolchainv1alpha1.UserSignupStatus{CompliantUsername: "john"},
+			},
+			Test: "TestSignup/john",
+		},
+		// the objects of the test are retained by the cleanup policy
+		{Object: &toolchainv1alpha1.SocialEvent{ObjectMeta: metav1.ObjectMeta{Name: "retained", Namespace: "host"}}, Test: "TestRetained"},
+		{Object: &toolchainv1alpha1.Space{ObjectMeta: metav1.ObjectMeta{Name: "jane", Namespace: "host"}}, Test: "TestRetained"},
+	}
+
+	// when
+	leaks := baseline.leaksIn(current, registrations, []string{"TestRetained"})
+
+	// then
+	assert.Equal(t, []Leak{
+		{Cluster: "host-cluster", Kind: "MasterUserRecord", Namespace: "host", Name: "john", Test: "TestSignup/john"},
+		{Cluster: "host-cluster", Kind: "Namespace", Namespace: "host", Name: "john-dev", Test: "TestSignup/john"},
+		{Cluster: "host-cluster", Kind: "ProxyPlugin", Namespace: "host", Name: "unknown"},
+		{Cluster: "host-cluster", Kind: "SocialEvent", Namespace: "host", Name: "annotated", Test: "TestAnnotated"},
+		{Cluster: "host-cluster", Kind: "SocialEvent", Namespace: "host", Name: "other-event"},
+		{Cluster: "host-cluster", Kind: "SocialEvent", Namespace: "host", Name: "registered", Test: "TestRegistered"},
+	}, leaks)
+}
+
+func TestPrintLeaks(t *testing.T) {
+	// given
+	out := &bytes.Buffer{}
+
+	// when
+	PrintLeaks(out, []Leak{
+		{Cluster: "member-cluster", Kind: "Namespace", Name: "john-dev", Test: "TestSignup"},
+		{Cluster: "host-cluster", Kind: "Space", Namespace: "host", Name: "john"},
+	})
+
+	// then
+	assert.Equal(t, `CLUSTER         KIND       NAMESPACE  NAME      TEST
+member-cluster  Namespace             john-dev  TestSignup
+host-cluster    Space      host       john      <unknown>
+`, out.String())
+}
@@ -0,0 +1,90 @@
+package wait
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// defaultContainerAnnotation is the annotation used by kubectl to select the container of a pod when none is specified
+	defaultContainerAnnotation = "kubectl.kubernetes.io/default-container"
+	// managerContainer is the name of the container running the controllers in the pods of the operators
+	managerContainer = "manager"
+)
+
+// waitForPodLogLine waits until the given pod logs a line matching the regular expression since the given time, and returns that line.
+// The logs of the default container of the pod (see defaultContainer) are streamed until a line matches, the timeout is reached or
+// the test is done.
+func (a *Awaitility) waitForPodLogLine(t testing.TB, pod corev1.Pod, regex *regexp.Regexp, since time.Time) (string, error) {
+	container := defaultContainer(pod)
+	t.Logf("waiting for the container '%s' of the pod '%s/%s' to log a line matching '%s'", container, pod.Namespace, pod.Name, regex)
+	clientset, err := kubernetes.NewForConfig(a.RestConfig)
+	if err != nil {
+		return "", err
+	}
+
+	var line string
+	sinceTime := metav1.NewTime(since)
+	err = a.poll(t, a.Timeout, func(ctx context.Context) (bool, error) {
+		logs, err := clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+			Container: container,
+			SinceTime: &sinceTime,
+			Follow:    true,
+		}).Stream(ctx)
+		if err != nil {
+			// the container may not be started yet
+			t.Logf("unable to stream the logs of the container '%s' of the pod '%s/%s': %s", container, pod.Namespace, pod.Name, err)
+			return false, nil
+		}
+		defer logs.Close()
+		scanner := bufio.NewScanner(logs)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			if regex.MatchString(scanner.Text()) {
+				line = scanner.Text()
+				return true, nil
+			}
+		}
+		// the stream ends when the container terminates or the context is done: start again from the same time
+		// in the former case, as the container may be restarted
+		return false, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("the container '%s' of the pod '%s/%s' didn't log any line matching '%s' since %s: %w",
+			container, pod.Namespace, pod.Name, regex, since.Format(time.RFC3339), err)
+	}
+	t.Logf("the container '%s' of the pod '%s/%s' logged the line: %s", container, pod.Namespace, pod.Name, line)
+	return line, nil
+}
+
+// defaultContainer returns the name of the container of the pod set in its `kubectl.kubernetes.io/default-container` annotation,
+// or else its `manager` container, or else the container named after the workload owning the pod (eg, the `host-operator` container
+// of a `host-operator-7d9f8c6b5-x2k4p` pod), or else its first container
+func defaultContainer(pod corev1.Pod) string {
+	if name, ok := pod.Annotations[defaultContainerAnnotation]; ok {
+		return name
+	}
+	for _, c := range pod.Spec.Containers {
+		if c.Name == managerContainer {
+			return c.Name
+		}
+	}
+	for _, c := range pod.Spec.Containers {
+		if strings.HasPrefix(pod.Name, c.Name+"-") {
+			return c.Name
+		}
+	}
+	if len(pod.Spec.Containers) > 0 {
+		return pod.Spec.Containers[0].Name
+	}
+	return ""
+}
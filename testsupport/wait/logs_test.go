@@ -0,0 +1,134 @@
+package wait
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+func TestDefaultContainer(t *testing.T) {
+	t.Run("container in the annotation", func(t *testing.T) {
+		// given
+		pod := corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{defaultContainerAnnotation: "kube-rbac-proxy"}},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "kube-rbac-proxy"}, {Name: "manager"}}},
+		}
+
+		// when
+		container := defaultContainer(pod)
+
+		// then
+		assert.Equal(t, "kube-rbac-proxy", container)
+	})
+
+	t.Run("manager container", func(t *testing.T) {
+		// given
+		pod := corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "host-operator-controller-manager-7d9f8c6b5-x2k4p"},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "kube-rbac-proxy"}, {Name: "manager"}}},
+		}
+
+		// when
+		container := defaultContainer(pod)
+
+		// then
+		assert.Equal(t, "manager", container)
+	})
+
+	t.Run("container named after the workload", func(t *testing.T) {
+		// given
+		pod := corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "registration-service-5c8b9d7f4-q8w2z"},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "kube-rbac-proxy"}, {Name: "registration-service"}}},
+		}
+
+		// when
+		container := defaultContainer(pod)
+
+		// then
+		assert.Equal(t, "registration-service", container)
+	})
+
+	t.Run("first container", func(t *testing.T) {
+		// given
+		pod := corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod-1"},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "kube-rbac-proxy"}, {Name: "proxy"}}},
+		}
+
+		// when
+		container := defaultContainer(pod)
+
+		// then
+		assert.Equal(t, "kube-rbac-proxy", container)
+	})
+}
+
+func TestWaitForPodLogLine(t *testing.T) {
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "operator", Name: "host-operator-controller-manager-1"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "kube-rbac-proxy"}, {Name: "manager"}}},
+	}
+	since := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// newLogServer returns an API server which fails to stream the logs of the pod the first time, as if the container was not started,
+	// then streams the given lines and closes the stream
+	newLogServer := func(t *testing.T, lines ...string) (*httptest.Server, *atomic.Int32) {
+		requests := &atomic.Int32{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v1/namespaces/operator/pods/host-operator-controller-manager-1/log", r.URL.Path)
+			assert.Equal(t, "manager", r.URL.Query().Get("container"))
+			assert.Equal(t, "true", r.URL.Query().Get("follow"))
+			assert.Equal(t, since.Format(time.RFC3339), r.URL.Query().Get("sinceTime"))
+			if requests.Add(1) == 1 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			for _, l := range lines {
+				_, _ = fmt.Fprintln(w, l)
+			}
+		}))
+		t.Cleanup(server.Close)
+		return server, requests
+	}
+
+	t.Run("line found", func(t *testing.T) {
+		// given
+		server, requests := newLogServer(t, `{"msg":"reconciling"}`, `{"msg":"idled pod","pod":"pod-1"}`, `{"msg":"idled pod","pod":"pod-2"}`)
+		config := &rest.Config{Host: server.URL, QPS: -1} // no client-side rate limiting
+		a := &Awaitility{RestConfig: config, RetryInterval: time.Millisecond, Timeout: time.Second}
+
+		// when
+		line, err := a.waitForPodLogLine(t, pod, regexp.MustCompile(`"msg":"idled pod"`), since)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, `{"msg":"idled pod","pod":"pod-1"}`, line)
+		assert.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("line not found", func(t *testing.T) {
+		// given
+		server, requests := newLogServer(t, `{"msg":"reconciling"}`)
+		config := &rest.Config{Host: server.URL, QPS: -1} // no client-side rate limiting
+		a := &Awaitility{RestConfig: config, RetryInterval: time.Millisecond, Timeout: 100 * time.Millisecond}
+
+		// when
+		_, err := a.waitForPodLogLine(t, pod, regexp.MustCompile(`"msg":"idled pod"`), since)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "the container 'manager' of the pod 'operator/host-operator-controller-manager-1' didn't log any line matching")
+		assert.Greater(t, requests.Load(), int32(2)) // the stream is opened again after it ends
+	})
+}
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"regexp"
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/dynamic"
@@ -35,7 +37,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -55,8 +56,21 @@ var (
 
 type MemberAwaitility struct {
 	*Awaitility
+	// ClusterLabels contains the labels of the ToolchainCluster of the member in the host cluster
+	ClusterLabels map[string]string
+	capabilities  map[Capability]bool
 }
 
+// Capability is a feature which is not available in all the member clusters
+type Capability string
+
+const (
+	// WebhookCapability is the capability of the member clusters in which the member operator webhook is deployed
+	WebhookCapability Capability = "webhook"
+	// AutoscalingBufferCapability is the capability of the member clusters in which the autoscaling buffer is deployed
+	AutoscalingBufferCapability Capability = "autoscaling-buffer"
+)
+
 func NewMemberAwaitility(cfg *rest.Config, cl client.Client, ns, clusterName string) *MemberAwaitility {
 	return &MemberAwaitility{
 		Awaitility: &Awaitility{
@@ -72,7 +86,7 @@ func NewMemberAwaitility(cfg *rest.Config, cl client.Client, ns, clusterName str
 
 // WaitForMetricsService verifies that there is a service called `host-operator-metrics-service`
 // in the member namespace.
-func (a *MemberAwaitility) WaitForMetricsService(t *testing.T) {
+func (a *MemberAwaitility) WaitForMetricsService(t testing.TB) {
 	_, err := a.WaitForService(t, "member-operator-metrics-service")
 	require.NoError(t, err, "failed while waiting for 'member-operator-metrics-service' service")
 }
@@ -83,7 +97,7 @@ const (
 )
 
 // InitMetricsAssertion waits for any pending usersignups and then initialized the metrics assertion helper with baseline values
-func (a *MemberAwaitility) InitMetrics(t *testing.T) {
+func (a *MemberAwaitility) InitMetrics(t testing.TB) {
 	a.WaitForMetricsService(t)
 	// Capture baseline values
 	a.baselineValues = make(map[string]float64)
@@ -93,45 +107,25 @@ func (a *MemberAwaitility) InitMetrics(t *testing.T) {
 
 func (a *MemberAwaitility) WithRetryOptions(options ...RetryOption) *MemberAwaitility {
 	return &MemberAwaitility{
-		Awaitility: a.Awaitility.WithRetryOptions(options...),
+		Awaitility:    a.Awaitility.WithRetryOptions(options...),
+		ClusterLabels: a.ClusterLabels,
+		capabilities:  a.capabilities,
 	}
 }
 
-// UserAccountWaitCriterion a struct to compare with a given UserAccount
-type UserAccountWaitCriterion struct {
-	Match func(*toolchainv1alpha1.UserAccount) bool
-	Diff  func(*toolchainv1alpha1.UserAccount) string
-}
-
-func matchUserAccountWaitCriterion(actual *toolchainv1alpha1.UserAccount, criteria ...UserAccountWaitCriterion) bool {
-	for _, c := range criteria {
-		if !c.Match(actual) {
-			return false
-		}
+// AddCapabilities records that the member cluster has the given capabilities, once they were verified
+func (a *MemberAwaitility) AddCapabilities(capabilities ...Capability) {
+	if a.capabilities == nil {
+		a.capabilities = map[Capability]bool{}
+	}
+	for _, c := range capabilities {
+		a.capabilities[c] = true
 	}
-	return true
 }
 
-func (a *MemberAwaitility) printUserAccountWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.UserAccount, criteria ...UserAccountWaitCriterion) {
-	buf := &strings.Builder{}
-	if actual == nil {
-		buf.WriteString("failed to find UserAccount\n")
-		buf.WriteString(a.listAndReturnContent("UserAccount", a.Namespace, &toolchainv1alpha1.UserAccountList{}))
-	} else {
-		buf.WriteString("failed to find UserAccount with matching criteria:\n")
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "actual:")
-		y, _ := StringifyObject(actual)
-		fmt.Fprintln(buf, string(y))
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "diffs:")
-		for _, c := range criteria {
-			if !c.Match(actual) && c.Diff != nil {
-				fmt.Fprintln(buf, c.Diff(actual))
-			}
-		}
-	}
-	t.Log(buf.String())
+// HasCapability returns true if the member cluster has the given capability
+func (a *MemberAwaitility) HasCapability(capability Capability) bool {
+	return a.capabilities[capability]
 }
 
 // UntilUserAccountHasLabelWithValue returns a `UserAccountWaitCriterion` which checks that the given
@@ -249,49 +243,47 @@ func UntilUserAccountIsCreatedAfter(timestamp metav1.Time) UserAccountWaitCriter
 }
 
 // WaitForUserAccount waits until there is a UserAccount available with the given name, expected spec and the set of status conditions
-func (a *MemberAwaitility) WaitForUserAccount(t *testing.T, name string, criteria ...UserAccountWaitCriterion) (*toolchainv1alpha1.UserAccount, error) {
+func (a *MemberAwaitility) WaitForUserAccount(t testing.TB, name string, criteria ...UserAccountWaitCriterion) (*toolchainv1alpha1.UserAccount, error) {
 	var userAccount *toolchainv1alpha1.UserAccount
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.UserAccount{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &toolchainv1alpha1.UserAccount{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Namespace: a.Namespace, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
 			return false, err
 		}
 		userAccount = obj
-		return matchUserAccountWaitCriterion(obj, criteria...), nil
+		return matchWaitCriteria(obj, criteria...), nil
 	})
 	// no match found, print the diffs
 	if err != nil {
-		a.printUserAccountWaitCriterionDiffs(t, userAccount, criteria...)
+		// include also all UserAccounts in the member namespace, to help troubleshooting
+		a.listAndPrint(t, "UserAccounts", a.Namespace, &toolchainv1alpha1.UserAccountList{})
+		printWaitCriteriaDiffs(t, "UserAccount", userAccount, criteria...)
 	}
 	return userAccount, err
 }
 
-// SpaceRequestWaitCriterion a struct to compare with a given SpaceRequest
-type SpaceRequestWaitCriterion struct {
-	Match func(request *toolchainv1alpha1.SpaceRequest) bool
-	Diff  func(request *toolchainv1alpha1.SpaceRequest) string
-}
-
 // WaitForSpaceRequest waits until there is a SpaceRequest available with the given name, namespace, spec and the set of status conditions
-func (a *MemberAwaitility) WaitForSpaceRequest(t *testing.T, namespacedName types.NamespacedName, criteria ...SpaceRequestWaitCriterion) (*toolchainv1alpha1.SpaceRequest, error) {
+func (a *MemberAwaitility) WaitForSpaceRequest(t testing.TB, namespacedName types.NamespacedName, criteria ...SpaceRequestWaitCriterion) (*toolchainv1alpha1.SpaceRequest, error) {
 	var spaceRequest *toolchainv1alpha1.SpaceRequest
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.SpaceRequest{}, namespacedName.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &toolchainv1alpha1.SpaceRequest{}
-		if err := a.Client.Get(context.TODO(), namespacedName, obj); err != nil {
+		if err := a.Client.Get(ctx, namespacedName, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
 			return false, err
 		}
 		spaceRequest = obj
-		return matchSpaceRequestWaitCriterion(obj, criteria...), nil
+		return matchWaitCriteria(obj, criteria...), nil
 	})
 	// no match found, print the diffs
 	if err != nil {
-		a.printSpaceRequestWaitCriterionDiffs(t, spaceRequest, criteria...)
+		// include also all SpaceRequests in the member namespace, to help troubleshooting
+		a.listAndPrint(t, "SpaceRequests", a.Namespace, &toolchainv1alpha1.SpaceRequestList{})
+		printWaitCriteriaDiffs(t, "SpaceRequest", spaceRequest, criteria...)
 	}
 	return spaceRequest, err
 }
@@ -422,60 +414,25 @@ func UntilSpaceRequestHasConditions(expected ...toolchainv1alpha1.Condition) Spa
 	}
 }
 
-func matchSpaceRequestWaitCriterion(actual *toolchainv1alpha1.SpaceRequest, criteria ...SpaceRequestWaitCriterion) bool {
-	for _, c := range criteria {
-		if !c.Match(actual) {
-			return false
-		}
-	}
-	return true
-}
-
-func (a *MemberAwaitility) printSpaceRequestWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.SpaceRequest, criteria ...SpaceRequestWaitCriterion) {
-	buf := &strings.Builder{}
-	if actual == nil {
-		buf.WriteString("failed to find SpaceRequest\n")
-		buf.WriteString(a.listAndReturnContent("SpaceRequest", a.Namespace, &toolchainv1alpha1.SpaceRequestList{}))
-	} else {
-		buf.WriteString("failed to find SpaceRequest with matching criteria:\n")
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "actual:")
-		y, _ := StringifyObject(actual)
-		fmt.Fprintln(buf, string(y))
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "diffs:")
-		for _, c := range criteria {
-			if !c.Match(actual) && c.Diff != nil {
-				fmt.Fprintln(buf, c.Diff(actual))
-			}
-		}
-	}
-	t.Log(buf.String())
-}
-
-// SpaceBindingRequestWaitCriterion a struct to compare with a given SpaceBindingRequest
-type SpaceBindingRequestWaitCriterion struct {
-	Match func(request *toolchainv1alpha1.SpaceBindingRequest) bool
-	Diff  func(request *toolchainv1alpha1.SpaceBindingRequest) string
-}
-
 // WaitForSpaceBindingRequest waits until there is a SpaceBindingRequest available with the given name, namespace, spec and the set of status conditions
-func (a *MemberAwaitility) WaitForSpaceBindingRequest(t *testing.T, namespacedName types.NamespacedName, criteria ...SpaceBindingRequestWaitCriterion) (*toolchainv1alpha1.SpaceBindingRequest, error) {
+func (a *MemberAwaitility) WaitForSpaceBindingRequest(t testing.TB, namespacedName types.NamespacedName, criteria ...SpaceBindingRequestWaitCriterion) (*toolchainv1alpha1.SpaceBindingRequest, error) {
 	var spaceBindingRequest *toolchainv1alpha1.SpaceBindingRequest
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.SpaceBindingRequest{}, namespacedName.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &toolchainv1alpha1.SpaceBindingRequest{}
-		if err := a.Client.Get(context.TODO(), namespacedName, obj); err != nil {
+		if err := a.Client.Get(ctx, namespacedName, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
 			return false, err
 		}
 		spaceBindingRequest = obj
-		return matchSpaceBindingRequestWaitCriterion(obj, criteria...), nil
+		return matchWaitCriteria(obj, criteria...), nil
 	})
 	// no match found, print the diffs
 	if err != nil {
-		a.printSpaceBindingRequestWaitCriterionDiffs(t, spaceBindingRequest, criteria...)
+		// include also all SpaceBindingRequests in the member namespace, to help troubleshooting
+		a.listAndPrint(t, "SpaceBindingRequests", a.Namespace, &toolchainv1alpha1.SpaceBindingRequestList{})
+		printWaitCriteriaDiffs(t, "SpaceBindingRequest", spaceBindingRequest, criteria...)
 	}
 	return spaceBindingRequest, err
 }
@@ -519,37 +476,6 @@ func UntilSpaceBindingRequestHasSpecMUR(expected string) SpaceBindingRequestWait
 	}
 }
 
-func matchSpaceBindingRequestWaitCriterion(actual *toolchainv1alpha1.SpaceBindingRequest, criteria ...SpaceBindingRequestWaitCriterion) bool {
-	for _, c := range criteria {
-		if !c.Match(actual) {
-			return false
-		}
-	}
-	return true
-}
-
-func (a *MemberAwaitility) printSpaceBindingRequestWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.SpaceBindingRequest, criteria ...SpaceBindingRequestWaitCriterion) {
-	buf := &strings.Builder{}
-	if actual == nil {
-		buf.WriteString("failed to find SpaceBindingRequest\n")
-		buf.WriteString(a.listAndReturnContent("SpaceBindingRequest", a.Namespace, &toolchainv1alpha1.SpaceBindingRequestList{}))
-	} else {
-		buf.WriteString("failed to find SpaceBindingRequest with matching criteria:\n")
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "actual:")
-		y, _ := StringifyObject(actual)
-		fmt.Fprintln(buf, string(y))
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "diffs:")
-		for _, c := range criteria {
-			if !c.Match(actual) && c.Diff != nil {
-				fmt.Fprintln(buf, c.Diff(actual))
-			}
-		}
-	}
-	t.Log(buf.String())
-}
-
 func (a *MemberAwaitility) ListSpaceBindingRequests(namespace string) ([]toolchainv1alpha1.SpaceBindingRequest, error) {
 	bindings := &toolchainv1alpha1.SpaceBindingRequestList{}
 	if err := a.Client.List(context.TODO(), bindings, client.InNamespace(namespace)); err != nil {
@@ -558,43 +484,6 @@ func (a *MemberAwaitility) ListSpaceBindingRequests(namespace string) ([]toolcha
 	return bindings.Items, nil
 }
 
-// NSTemplateSetWaitCriterion a struct to compare with a given NSTemplateSet
-type NSTemplateSetWaitCriterion struct {
-	Match func(*toolchainv1alpha1.NSTemplateSet) bool
-	Diff  func(*toolchainv1alpha1.NSTemplateSet) string
-}
-
-func matchNSTemplateSetWaitCriterion(actual *toolchainv1alpha1.NSTemplateSet, criteria ...NSTemplateSetWaitCriterion) bool {
-	for _, c := range criteria {
-		if !c.Match(actual) {
-			return false
-		}
-	}
-	return true
-}
-
-func (a *MemberAwaitility) printNSTemplateSetWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.NSTemplateSet, criteria ...NSTemplateSetWaitCriterion) {
-	buf := &strings.Builder{}
-	if actual == nil {
-		fmt.Fprintln(buf, "failed to find NSTemplateSet at all")
-		fmt.Fprint(buf, a.listAndReturnContent("NSTemplateSet", a.Namespace, &toolchainv1alpha1.NSTemplateSetList{}))
-	} else {
-		fmt.Fprintf(buf, "failed to find NSTemplateSet with matching criteria after %fs:\n", a.Timeout.Seconds())
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "actual:")
-		y, _ := StringifyObject(actual)
-		fmt.Fprintln(buf, string(y))
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "diffs:")
-		for _, c := range criteria {
-			if !c.Match(actual) {
-				fmt.Fprintln(buf, c.Diff(actual))
-			}
-		}
-	}
-	t.Log(buf.String())
-}
-
 // UntilNSTemplateSetHasNoOwnerReferences returns a `NSTemplateSetWaitCriterion` which checks that the given
 // NSTemplateSet has no Owner References
 func UntilNSTemplateSetHasNoOwnerReferences() NSTemplateSetWaitCriterion {
@@ -724,33 +613,35 @@ func UntilNSTemplateSetHasProvisionedNamespaces(expected []toolchainv1alpha1.Spa
 }
 
 // WaitForNSTmplSet wait until the NSTemplateSet with the given name and conditions exists
-func (a *MemberAwaitility) WaitForNSTmplSet(t *testing.T, name string, criteria ...NSTemplateSetWaitCriterion) (*toolchainv1alpha1.NSTemplateSet, error) {
+func (a *MemberAwaitility) WaitForNSTmplSet(t testing.TB, name string, criteria ...NSTemplateSetWaitCriterion) (*toolchainv1alpha1.NSTemplateSet, error) {
 	t.Logf("waiting for NSTemplateSet '%s' to match criteria", name)
 	var nsTmplSet *toolchainv1alpha1.NSTemplateSet
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.NSTemplateSet{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &toolchainv1alpha1.NSTemplateSet{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: a.Namespace}, obj); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: a.Namespace}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
 			return false, err
 		}
 		nsTmplSet = obj
-		return matchNSTemplateSetWaitCriterion(obj, criteria...), nil
+		return matchWaitCriteria(obj, criteria...), nil
 	})
 	// no match found, print the diffs
 	if err != nil {
-		a.printNSTemplateSetWaitCriterionDiffs(t, nsTmplSet, criteria...)
+		// include also all NSTemplateSets in the member namespace, to help troubleshooting
+		a.listAndPrint(t, "NSTemplateSets", a.Namespace, &toolchainv1alpha1.NSTemplateSetList{})
+		printWaitCriteriaDiffs(t, "NSTemplateSet", nsTmplSet, criteria...)
 	}
 	return nsTmplSet, err
 }
 
 // WaitUntilNSTemplateSetDeleted waits until the NSTemplateSet with the given name is deleted (ie, is not found)
-func (a *MemberAwaitility) WaitUntilNSTemplateSetDeleted(t *testing.T, name string) error {
+func (a *MemberAwaitility) WaitUntilNSTemplateSetDeleted(t testing.TB, name string) error {
 	t.Logf("waiting for until NSTemplateSet '%s' in namespace '%s' is deleted", name, a.Namespace)
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	return a.waitUntilWatched(t, &toolchainv1alpha1.NSTemplateSet{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		nsTmplSet := &toolchainv1alpha1.NSTemplateSet{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: a.Namespace}, nsTmplSet); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: a.Namespace}, nsTmplSet); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -824,7 +715,7 @@ func matchNamespaceWaitCriteria(actual *corev1.Namespace, criteria ...NamespaceW
 }
 
 // WaitForNamespace waits until a namespace with the given owner (username), type, revision and tier labels exists
-func (a *MemberAwaitility) WaitForNamespace(t *testing.T, owner, tmplRef, tierName string, criteria ...NamespaceWaitCriterion) (*corev1.Namespace, error) {
+func (a *MemberAwaitility) WaitForNamespace(t testing.TB, owner, tmplRef, tierName string, criteria ...NamespaceWaitCriterion) (*corev1.Namespace, error) {
 	_, kind, err := TierAndType(tmplRef)
 	if err != nil {
 		return nil, err
@@ -838,10 +729,10 @@ func (a *MemberAwaitility) WaitForNamespace(t *testing.T, owner, tmplRef, tierNa
 	}
 	t.Logf("waiting for namespace with custom criteria and labels %v", labels)
 	var ns *corev1.Namespace
-	err = wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err = a.waitUntilWatched(t, &corev1.Namespace{}, "", a.Timeout, func(ctx context.Context) (done bool, err error) {
 		nss := &corev1.NamespaceList{}
 		opts := client.MatchingLabels(labels)
-		if err := a.Client.List(context.TODO(), nss, opts); err != nil {
+		if err := a.Client.List(ctx, nss, opts); err != nil {
 			return false, err
 		}
 		if len(nss.Items) != 1 {
@@ -870,11 +761,11 @@ func (a *MemberAwaitility) WaitForNamespace(t *testing.T, owner, tmplRef, tierNa
 }
 
 // WaitForNamespaceWithName waits until a namespace with the given name
-func (a *MemberAwaitility) WaitForNamespaceWithName(t *testing.T, name string, criteria ...LabelWaitCriterion) (*corev1.Namespace, error) {
+func (a *MemberAwaitility) WaitForNamespaceWithName(t testing.TB, name string, criteria ...LabelWaitCriterion) (*corev1.Namespace, error) {
 	ns := &corev1.Namespace{}
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(wa context.Context) (done bool, err error) {
+	err := a.poll(t, a.Timeout, func(wa context.Context) (done bool, err error) {
 		obj := &corev1.Namespace{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name}, obj); err != nil {
+		if err := a.Client.Get(wa, types.NamespacedName{Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -900,7 +791,7 @@ func matchLabelWaitCriteria(actual metav1.ObjectMeta, criteria ...LabelWaitCrite
 	return true
 }
 
-func (a *MemberAwaitility) printNamespaceLabelCriterionDiffs(t *testing.T, actual *corev1.Namespace, criteria ...LabelWaitCriterion) {
+func (a *MemberAwaitility) printNamespaceLabelCriterionDiffs(t testing.TB, actual *corev1.Namespace, criteria ...LabelWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find Namespace\n")
@@ -923,11 +814,11 @@ func (a *MemberAwaitility) printNamespaceLabelCriterionDiffs(t *testing.T, actua
 }
 
 // WaitForNamespaceInTerminating waits until a namespace with the given name has a deletion timestamp and in Terminating Phase
-func (a *MemberAwaitility) WaitForNamespaceInTerminating(t *testing.T, nsName string) (*corev1.Namespace, error) {
+func (a *MemberAwaitility) WaitForNamespaceInTerminating(t testing.TB, nsName string) (*corev1.Namespace, error) {
 	ns := &corev1.Namespace{}
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &corev1.Namespace{}, "", a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &corev1.Namespace{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: nsName}, obj); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Name: nsName}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -943,7 +834,7 @@ func (a *MemberAwaitility) WaitForNamespaceInTerminating(t *testing.T, nsName st
 	return ns, nil
 }
 
-func (a *MemberAwaitility) printRoleBindingWaitCriterionDiffs(t *testing.T, actual *rbacv1.RoleBinding, criteria ...LabelWaitCriterion) {
+func (a *MemberAwaitility) printRoleBindingWaitCriterionDiffs(t testing.TB, actual *rbacv1.RoleBinding, criteria ...LabelWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find RoleBinding\n")
@@ -966,12 +857,12 @@ func (a *MemberAwaitility) printRoleBindingWaitCriterionDiffs(t *testing.T, actu
 }
 
 // WaitForRoleBinding waits until a RoleBinding with the given name exists in the given namespace
-func (a *MemberAwaitility) WaitForRoleBinding(t *testing.T, namespace *corev1.Namespace, name string, criteria ...LabelWaitCriterion) (*rbacv1.RoleBinding, error) {
+func (a *MemberAwaitility) WaitForRoleBinding(t testing.TB, namespace *corev1.Namespace, name string, criteria ...LabelWaitCriterion) (*rbacv1.RoleBinding, error) {
 	t.Logf("waiting for RoleBinding '%s' in namespace '%s'", name, namespace.Name)
 	roleBinding := &rbacv1.RoleBinding{}
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &rbacv1.RoleBinding{}, namespace.Name, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &rbacv1.RoleBinding{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace.Name, Name: name}, obj); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Namespace: namespace.Name, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -990,11 +881,11 @@ func (a *MemberAwaitility) WaitForRoleBinding(t *testing.T, namespace *corev1.Na
 }
 
 // WaitUntilRoleBindingDeleted waits until a RoleBinding with the given name does not exist anymore in the given namespace
-func (a *MemberAwaitility) WaitUntilRoleBindingDeleted(t *testing.T, namespace *corev1.Namespace, name string) error {
+func (a *MemberAwaitility) WaitUntilRoleBindingDeleted(t testing.TB, namespace *corev1.Namespace, name string) error {
 	t.Logf("waiting for RoleBinding '%s' in namespace '%s' to be deleted", name, namespace.Name)
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	return a.waitUntilWatched(t, &rbacv1.RoleBinding{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		roleBinding := &rbacv1.RoleBinding{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: a.Namespace}, roleBinding); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: a.Namespace}, roleBinding); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -1004,12 +895,12 @@ func (a *MemberAwaitility) WaitUntilRoleBindingDeleted(t *testing.T, namespace *
 	})
 }
 
-func (a *MemberAwaitility) WaitForServiceAccount(t *testing.T, namespace string, name string, criteria ...LabelWaitCriterion) (*corev1.ServiceAccount, error) {
+func (a *MemberAwaitility) WaitForServiceAccount(t testing.TB, namespace string, name string, criteria ...LabelWaitCriterion) (*corev1.ServiceAccount, error) {
 	t.Logf("waiting for ServiceAccount '%s' in namespace '%s'", name, namespace)
 	serviceAccount := &corev1.ServiceAccount{}
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &corev1.ServiceAccount{}, namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &corev1.ServiceAccount{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -1026,15 +917,15 @@ func (a *MemberAwaitility) WaitForServiceAccount(t *testing.T, namespace string,
 }
 
 // WaitForLimitRange waits until a LimitRange with the given name exists in the given namespace
-func (a *MemberAwaitility) WaitForLimitRange(t *testing.T, namespace *corev1.Namespace, name string) (*corev1.LimitRange, error) {
+func (a *MemberAwaitility) WaitForLimitRange(t testing.TB, namespace *corev1.Namespace, name string) (*corev1.LimitRange, error) {
 	t.Logf("waiting for LimitRange '%s' in namespace '%s'", name, namespace.Name)
 	lr := &corev1.LimitRange{}
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &corev1.LimitRange{}, namespace.Name, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &corev1.LimitRange{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace.Name, Name: name}, obj); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Namespace: namespace.Name, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				allLRs := &corev1.LimitRangeList{}
-				if err := a.Client.List(context.TODO(), allLRs, client.MatchingLabels(codereadyToolchainProviderLabel)); err != nil {
+				if err := a.Client.List(ctx, allLRs, client.MatchingLabels(codereadyToolchainProviderLabel)); err != nil {
 					return false, err
 				}
 				return false, nil
@@ -1051,15 +942,15 @@ func (a *MemberAwaitility) WaitForLimitRange(t *testing.T, namespace *corev1.Nam
 }
 
 // WaitForNetworkPolicy waits until a NetworkPolicy with the given name exists in the given namespace
-func (a *MemberAwaitility) WaitForNetworkPolicy(t *testing.T, namespace *corev1.Namespace, name string) (*netv1.NetworkPolicy, error) {
+func (a *MemberAwaitility) WaitForNetworkPolicy(t testing.TB, namespace *corev1.Namespace, name string) (*netv1.NetworkPolicy, error) {
 	t.Logf("waiting for NetworkPolicy '%s' in namespace '%s'", name, namespace.Name)
 	np := &netv1.NetworkPolicy{}
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &netv1.NetworkPolicy{}, namespace.Name, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &netv1.NetworkPolicy{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace.Name, Name: name}, obj); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Namespace: namespace.Name, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				allNPs := &netv1.NetworkPolicyList{}
-				if err := a.Client.List(context.TODO(), allNPs, client.MatchingLabels(codereadyToolchainProviderLabel)); err != nil {
+				if err := a.Client.List(ctx, allNPs, client.MatchingLabels(codereadyToolchainProviderLabel)); err != nil {
 					return false, err
 				}
 				return false, nil
@@ -1076,12 +967,12 @@ func (a *MemberAwaitility) WaitForNetworkPolicy(t *testing.T, namespace *corev1.
 }
 
 // WaitForRole waits until a Role with the given name exists in the given namespace
-func (a *MemberAwaitility) WaitForRole(t *testing.T, namespace *corev1.Namespace, name string, criteria ...LabelWaitCriterion) (*rbacv1.Role, error) {
+func (a *MemberAwaitility) WaitForRole(t testing.TB, namespace *corev1.Namespace, name string, criteria ...LabelWaitCriterion) (*rbacv1.Role, error) {
 	t.Logf("waiting for Role '%s' in namespace '%s'", name, namespace.Name)
 	role := &rbacv1.Role{}
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &rbacv1.Role{}, namespace.Name, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &rbacv1.Role{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace.Name, Name: name}, obj); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Namespace: namespace.Name, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -1098,11 +989,11 @@ func (a *MemberAwaitility) WaitForRole(t *testing.T, namespace *corev1.Namespace
 }
 
 // WaitUntilRoleDeleted waits until a Role with the given name does not exist anymore in the given namespace
-func (a *MemberAwaitility) WaitUntilRoleDeleted(t *testing.T, namespace *corev1.Namespace, name string) error {
+func (a *MemberAwaitility) WaitUntilRoleDeleted(t testing.TB, namespace *corev1.Namespace, name string) error {
 	t.Logf("waiting for Role '%s' in namespace '%s' to be deleted", name, namespace.Name)
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	return a.waitUntilWatched(t, &rbacv1.Role{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		role := &rbacv1.Role{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: a.Namespace}, role); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: a.Namespace}, role); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -1112,7 +1003,7 @@ func (a *MemberAwaitility) WaitUntilRoleDeleted(t *testing.T, namespace *corev1.
 	})
 }
 
-func (a *MemberAwaitility) printRoleWaitCriterionDiffs(t *testing.T, actual *rbacv1.Role, criteria ...LabelWaitCriterion) {
+func (a *MemberAwaitility) printRoleWaitCriterionDiffs(t testing.TB, actual *rbacv1.Role, criteria ...LabelWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find Role\n")
@@ -1149,7 +1040,7 @@ func matchClusterResourceQuotaWaitCriteria(actual *quotav1.ClusterResourceQuota,
 	return true
 }
 
-func (a *MemberAwaitility) printClusterResourceQuotaWaitCriterionDiffs(t *testing.T, actual *quotav1.ClusterResourceQuota, criteria ...ClusterResourceQuotaWaitCriterion) {
+func (a *MemberAwaitility) printClusterResourceQuotaWaitCriterionDiffs(t testing.TB, actual *quotav1.ClusterResourceQuota, criteria ...ClusterResourceQuotaWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find ClusterResourceQuota\n")
@@ -1172,16 +1063,16 @@ func (a *MemberAwaitility) printClusterResourceQuotaWaitCriterionDiffs(t *testin
 }
 
 // WaitForClusterResourceQuota waits until a ClusterResourceQuota with the given name exists
-func (a *MemberAwaitility) WaitForClusterResourceQuota(t *testing.T, name string, criteria ...ClusterResourceQuotaWaitCriterion) (*quotav1.ClusterResourceQuota, error) {
+func (a *MemberAwaitility) WaitForClusterResourceQuota(t testing.TB, name string, criteria ...ClusterResourceQuotaWaitCriterion) (*quotav1.ClusterResourceQuota, error) {
 	t.Logf("waiting for ClusterResourceQuota '%s' to match criteria", name)
 	quota := &quotav1.ClusterResourceQuota{}
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &quotav1.ClusterResourceQuota{}, "", a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &quotav1.ClusterResourceQuota{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name}, obj); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				quotaList := &quotav1.ClusterResourceQuotaList{}
 				ls := codereadyToolchainProviderLabel
-				if err := a.Client.List(context.TODO(), quotaList, client.MatchingLabels(ls)); err != nil {
+				if err := a.Client.List(ctx, quotaList, client.MatchingLabels(ls)); err != nil {
 					return false, err
 				}
 				return false, nil
@@ -1213,7 +1104,7 @@ func matchResourceQuotaWaitCriteria(actual *corev1.ResourceQuota, criteria ...Re
 	return true
 }
 
-func (a *MemberAwaitility) printResourceQuotaWaitCriterionDiffs(t *testing.T, actual *corev1.ResourceQuota, criteria ...ResourceQuotaWaitCriterion) {
+func (a *MemberAwaitility) printResourceQuotaWaitCriterionDiffs(t testing.TB, actual *corev1.ResourceQuota, criteria ...ResourceQuotaWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find ResourceQuota\n")
@@ -1236,12 +1127,12 @@ func (a *MemberAwaitility) printResourceQuotaWaitCriterionDiffs(t *testing.T, ac
 }
 
 // WaitForResourceQuota waits until a ResourceQuota with the given name exists
-func (a *MemberAwaitility) WaitForResourceQuota(t *testing.T, namespace, name string, criteria ...ResourceQuotaWaitCriterion) (*corev1.ResourceQuota, error) {
+func (a *MemberAwaitility) WaitForResourceQuota(t testing.TB, namespace, name string, criteria ...ResourceQuotaWaitCriterion) (*corev1.ResourceQuota, error) {
 	t.Logf("waiting for ResourceQuota '%s' in %s to match criteria", name, namespace)
 	quota := &corev1.ResourceQuota{}
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &corev1.ResourceQuota{}, namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &corev1.ResourceQuota{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -1257,46 +1148,6 @@ func (a *MemberAwaitility) WaitForResourceQuota(t *testing.T, namespace, name st
 	return quota, err
 }
 
-// IdlerWaitCriterion a struct to compare with a given Idler
-type IdlerWaitCriterion struct {
-	Match func(*toolchainv1alpha1.Idler) bool
-	Diff  func(*toolchainv1alpha1.Idler) string
-}
-
-func matchIdlerWaitCriteria(actual *toolchainv1alpha1.Idler, criteria ...IdlerWaitCriterion) bool {
-	for _, c := range criteria {
-		// if at least one criteria does not match, keep waiting
-		if !c.Match(actual) {
-			return false
-		}
-	}
-	return true
-}
-
-func (a *MemberAwaitility) printIdlerWaitCriteriaDiffs(t *testing.T, actual *toolchainv1alpha1.Idler, criteria ...IdlerWaitCriterion) {
-	buf := &strings.Builder{}
-	if actual == nil {
-		buf.WriteString("failed to find Idler\n")
-		buf.WriteString(a.listAndReturnContent("Idler", "", &toolchainv1alpha1.IdlerList{}))
-		buf.WriteString(a.listAndReturnContent("Idler", "", &toolchainv1alpha1.IdlerList{}))
-	} else {
-		buf.WriteString("failed to find Idler with matching criteria:\n")
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "actual:")
-		y, _ := StringifyObject(actual)
-		fmt.Fprintln(buf, string(y))
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "diffs:")
-		for _, c := range criteria {
-			// if at least one criteria does not match, keep waiting
-			if !c.Match(actual) {
-				fmt.Fprintln(buf, c.Diff(actual))
-			}
-		}
-	}
-	t.Log(buf.String())
-}
-
 // IdlerConditions returns a `IdlerWaitCriterion` which checks that the given
 // Idler has exactly all the given status conditions
 func IdlerConditions(expected ...toolchainv1alpha1.Condition) IdlerWaitCriterion {
@@ -1347,23 +1198,25 @@ func IdlerHasLabel(key, value string) IdlerWaitCriterion {
 }
 
 // WaitForIdler waits until an Idler with the given name exists
-func (a *MemberAwaitility) WaitForIdler(t *testing.T, name string, criteria ...IdlerWaitCriterion) (*toolchainv1alpha1.Idler, error) {
+func (a *MemberAwaitility) WaitForIdler(t testing.TB, name string, criteria ...IdlerWaitCriterion) (*toolchainv1alpha1.Idler, error) {
 	t.Logf("waiting for Idler '%s' to match criteria", name)
 	idler := &toolchainv1alpha1.Idler{}
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.Idler{}, "", a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &toolchainv1alpha1.Idler{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name}, obj); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
 			return false, err
 		}
 		idler = obj
-		return matchIdlerWaitCriteria(obj, criteria...), nil
+		return matchWaitCriteria(obj, criteria...), nil
 	})
 	// no match found, print the diffs
 	if err != nil {
-		a.printIdlerWaitCriteriaDiffs(t, idler, criteria...)
+		// include also all Idlers, to help troubleshooting
+		a.listAndPrint(t, "Idlers", "", &toolchainv1alpha1.IdlerList{})
+		printWaitCriteriaDiffs(t, "Idler", idler, criteria...)
 	}
 	return idler, err
 }
@@ -1371,15 +1224,15 @@ func (a *MemberAwaitility) WaitForIdler(t *testing.T, name string, criteria ...I
 // UpdateSpaceBindingRequest tries to update the Spec of the given SpaceBindingRequest
 // If it fails with an error (for example if the object has been modified) then it retrieves the latest version and tries again
 // Returns the updated SpaceBindingRequest
-func (a *MemberAwaitility) UpdateSpaceBindingRequest(t *testing.T, spaceBindingRequestNamespacedName types.NamespacedName, modifySpaceBindingRequest func(s *toolchainv1alpha1.SpaceBindingRequest)) (*toolchainv1alpha1.SpaceBindingRequest, error) {
+func (a *MemberAwaitility) UpdateSpaceBindingRequest(t testing.TB, spaceBindingRequestNamespacedName types.NamespacedName, modifySpaceBindingRequest func(s *toolchainv1alpha1.SpaceBindingRequest)) (*toolchainv1alpha1.SpaceBindingRequest, error) {
 	var sr *toolchainv1alpha1.SpaceBindingRequest
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.SpaceBindingRequest{}, spaceBindingRequestNamespacedName.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		freshSpaceBindingRequest := &toolchainv1alpha1.SpaceBindingRequest{}
-		if err := a.Client.Get(context.TODO(), spaceBindingRequestNamespacedName, freshSpaceBindingRequest); err != nil {
+		if err := a.Client.Get(ctx, spaceBindingRequestNamespacedName, freshSpaceBindingRequest); err != nil {
 			return true, err
 		}
 		modifySpaceBindingRequest(freshSpaceBindingRequest)
-		if err := a.Client.Update(context.TODO(), freshSpaceBindingRequest); err != nil {
+		if err := a.Client.Update(ctx, freshSpaceBindingRequest); err != nil {
 			t.Logf("error updating SpaceBindingRequest '%s' in namespace '%s': %s. Will retry again...", spaceBindingRequestNamespacedName.Name, spaceBindingRequestNamespacedName.Name, err.Error())
 			return false, err
 		}
@@ -1390,11 +1243,11 @@ func (a *MemberAwaitility) UpdateSpaceBindingRequest(t *testing.T, spaceBindingR
 }
 
 // WaitUntilSpaceBindingRequestDeleted waits until a SpaceBindingRequest with the given name does not exist anymore in the given namespace
-func (a *MemberAwaitility) WaitUntilSpaceBindingRequestDeleted(t *testing.T, spaceBindingRequest *toolchainv1alpha1.SpaceBindingRequest) error {
+func (a *MemberAwaitility) WaitUntilSpaceBindingRequestDeleted(t testing.TB, spaceBindingRequest *toolchainv1alpha1.SpaceBindingRequest) error {
 	t.Logf("waiting for SpaceBindingRequest '%s' in namespace '%s' to be deleted", spaceBindingRequest.GetName(), spaceBindingRequest.GetNamespace())
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	return a.waitUntilWatched(t, &toolchainv1alpha1.SpaceBindingRequest{}, spaceBindingRequest.GetNamespace(), a.Timeout, func(ctx context.Context) (done bool, err error) {
 		sbr := &toolchainv1alpha1.SpaceBindingRequest{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: spaceBindingRequest.GetName(), Namespace: spaceBindingRequest.GetNamespace()}, sbr); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Name: spaceBindingRequest.GetName(), Namespace: spaceBindingRequest.GetNamespace()}, sbr); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -1404,11 +1257,12 @@ func (a *MemberAwaitility) WaitUntilSpaceBindingRequestDeleted(t *testing.T, spa
 	})
 }
 
-// Create tries to create the object until success
+// Create tries to create the object until success, with the labels of the current run and test (see StampRun)
 // Workaround for https://github.com/kubernetes/kubernetes/issues/67761
-func (a *MemberAwaitility) Create(t *testing.T, obj client.Object) error {
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
-		if err := a.Client.Create(context.TODO(), obj); err != nil {
+func (a *MemberAwaitility) Create(t testing.TB, obj client.Object) error {
+	StampRun(t, obj)
+	return a.poll(t, a.Timeout, func(ctx context.Context) (done bool, err error) {
+		if err := a.Client.Create(ctx, obj); err != nil {
 			t.Logf("trying to create %+v. Error: %s. Will try to create again.", obj, err.Error())
 			return false, nil
 		}
@@ -1418,11 +1272,12 @@ func (a *MemberAwaitility) Create(t *testing.T, obj client.Object) error {
 
 // CreateWithCleanup tries to create the object until success and schedules cleanup at test end.
 // Workaround for https://github.com/kubernetes/kubernetes/issues/67761
-func (a *MemberAwaitility) CreateWithCleanup(t *testing.T, obj client.Object) error {
+func (a *MemberAwaitility) CreateWithCleanup(t testing.TB, obj client.Object) error {
 	if err := a.Create(t, obj); err != nil {
 		return err
 	}
 	cleanup.AddCleanTasks(t, a.Client, obj)
+	a.Track(t, obj)
 	return nil
 }
 
@@ -1441,7 +1296,7 @@ func matchPodWaitCriterion(actual *corev1.Pod, criteria ...PodWaitCriterion) boo
 	return true
 }
 
-func (a *MemberAwaitility) printPodWaitCriterionDiffs(t *testing.T, actual *corev1.Pod, ns string, criteria ...PodWaitCriterion) {
+func (a *MemberAwaitility) printPodWaitCriterionDiffs(t testing.TB, actual *corev1.Pod, ns string, criteria ...PodWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find Pod\n")
@@ -1458,12 +1313,12 @@ func (a *MemberAwaitility) printPodWaitCriterionDiffs(t *testing.T, actual *core
 }
 
 // WaitForPod waits until a pod with the given name exists in the given namespace
-func (a *MemberAwaitility) WaitForPod(t *testing.T, namespace, name string, criteria ...PodWaitCriterion) (*corev1.Pod, error) {
+func (a *MemberAwaitility) WaitForPod(t testing.TB, namespace, name string, criteria ...PodWaitCriterion) (*corev1.Pod, error) {
 	t.Logf("waiting for Pod '%s' in namespace '%s' with matching criteria", name, namespace)
 	var pod *corev1.Pod
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &corev1.Pod{}, namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &corev1.Pod{}
-		if err = a.Client.Get(context.TODO(), types.NamespacedName{
+		if err = a.Client.Get(ctx, types.NamespacedName{
 			Namespace: namespace,
 			Name:      name,
 		}, obj); err != nil {
@@ -1485,12 +1340,12 @@ func (a *MemberAwaitility) WaitForPod(t *testing.T, namespace, name string, crit
 }
 
 // WaitForConfigMap waits until a ConfigMap with the given name exists in the given namespace
-func (a *MemberAwaitility) WaitForConfigMap(t *testing.T, namespace, name string) (*corev1.ConfigMap, error) {
+func (a *MemberAwaitility) WaitForConfigMap(t testing.TB, namespace, name string) (*corev1.ConfigMap, error) {
 	t.Logf("waiting for ConfigMap '%s' in namespace '%s'", name, namespace)
 	var cm *corev1.ConfigMap
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &corev1.ConfigMap{}, namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &corev1.ConfigMap{}
-		if err = a.Client.Get(context.TODO(), types.NamespacedName{
+		if err = a.Client.Get(ctx, types.NamespacedName{
 			Namespace: namespace,
 			Name:      name,
 		}, obj); err != nil {
@@ -1508,12 +1363,12 @@ func (a *MemberAwaitility) WaitForConfigMap(t *testing.T, namespace, name string
 }
 
 // WaitForSecret waits until a Secret with the given name exists in the operator namespace
-func (a *MemberAwaitility) WaitForSecret(t *testing.T, name string) (*corev1.Secret, error) {
+func (a *MemberAwaitility) WaitForSecret(t testing.TB, name string) (*corev1.Secret, error) {
 	t.Logf("waiting for Secret '%s' in namespace '%s'", name, a.Namespace)
 	var cm *corev1.Secret
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &corev1.Secret{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &corev1.Secret{}
-		if err = a.Client.Get(context.TODO(), types.NamespacedName{
+		if err = a.Client.Get(ctx, types.NamespacedName{
 			Namespace: a.Namespace,
 			Name:      name,
 		}, obj); err != nil {
@@ -1531,10 +1386,10 @@ func (a *MemberAwaitility) WaitForSecret(t *testing.T, name string) (*corev1.Sec
 }
 
 // WaitForAAP waits for the AAP resource to get into the expected idled state (Spec.Idle_aap)
-func (a *MemberAwaitility) WaitForAAP(t *testing.T, name, namespace string, aapRes dynamic.NamespaceableResourceInterface, expectedIdled bool) (*unstructured.Unstructured, error) {
+func (a *MemberAwaitility) WaitForAAP(t testing.TB, name, namespace string, aapRes dynamic.NamespaceableResourceInterface, expectedIdled bool) (*unstructured.Unstructured, error) {
 	t.Logf("waiting for AAP '%s' in namespace '%s'", name, a.Namespace)
 	var aap *unstructured.Unstructured
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (bool, error) {
+	err := a.poll(t, a.Timeout, func(ctx context.Context) (bool, error) {
 		var err error
 		aap, err = aapRes.Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
 		if err != nil {
@@ -1553,10 +1408,10 @@ func (a *MemberAwaitility) WaitForAAP(t *testing.T, name, namespace string, aapR
 }
 
 // WaitForClaw waits for the Claw resource to reach the expected idle state (spec.idle)
-func (a *MemberAwaitility) WaitForClaw(t *testing.T, name, namespace string, clawRes dynamic.NamespaceableResourceInterface, expectedIdled bool) (*unstructured.Unstructured, error) {
+func (a *MemberAwaitility) WaitForClaw(t testing.TB, name, namespace string, clawRes dynamic.NamespaceableResourceInterface, expectedIdled bool) (*unstructured.Unstructured, error) {
 	t.Logf("waiting for Claw '%s' in namespace '%s'", name, namespace)
 	var claw *unstructured.Unstructured
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (bool, error) {
+	err := a.poll(t, a.Timeout, func(ctx context.Context) (bool, error) {
 		var err error
 		claw, err = clawRes.Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
 		if err != nil {
@@ -1578,9 +1433,9 @@ func (a *MemberAwaitility) WaitForClaw(t *testing.T, name, namespace string, cla
 }
 
 // WaitUntilInferenceServiceDeleted waits for the InferenceService resource to be deleted (idled)
-func (a *MemberAwaitility) WaitUntilInferenceServiceDeleted(t *testing.T, name, namespace string, inferenceServiceRes dynamic.NamespaceableResourceInterface) error {
+func (a *MemberAwaitility) WaitUntilInferenceServiceDeleted(t testing.TB, name, namespace string, inferenceServiceRes dynamic.NamespaceableResourceInterface) error {
 	t.Logf("waiting for InferenceService '%s' to be deleted in namespace '%s'", name, namespace)
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (bool, error) {
+	err := a.poll(t, a.Timeout, func(ctx context.Context) (bool, error) {
 		_, err := inferenceServiceRes.Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
 		if err != nil {
 			if errors.IsNotFound(err) {
@@ -1594,9 +1449,9 @@ func (a *MemberAwaitility) WaitUntilInferenceServiceDeleted(t *testing.T, name,
 }
 
 // WaitUntilDataVolumeDeleted waits for the DataVolume resource to be deleted (idled)
-func (a *MemberAwaitility) WaitUntilDataVolumeDeleted(t *testing.T, name, namespace string, dataVolumeRes dynamic.NamespaceableResourceInterface) error {
+func (a *MemberAwaitility) WaitUntilDataVolumeDeleted(t testing.TB, name, namespace string, dataVolumeRes dynamic.NamespaceableResourceInterface) error {
 	t.Logf("waiting for DataVolume '%s' to be deleted in namespace '%s'", name, namespace)
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (bool, error) {
+	err := a.poll(t, a.Timeout, func(ctx context.Context) (bool, error) {
 		_, err := dataVolumeRes.Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
 		if err != nil {
 			if errors.IsNotFound(err) {
@@ -1610,10 +1465,10 @@ func (a *MemberAwaitility) WaitUntilDataVolumeDeleted(t *testing.T, name, namesp
 }
 
 // WaitUntilPVCDeleted waits for the PVC resource to be deleted (idled)
-func (a *MemberAwaitility) WaitUntilPVCDeleted(t *testing.T, name, namespace string) error {
+func (a *MemberAwaitility) WaitUntilPVCDeleted(t testing.TB, name, namespace string) error {
 	t.Logf("waiting for PVC '%s' to be deleted in namespace '%s'", name, namespace)
 	pvc := &corev1.PersistentVolumeClaim{}
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (bool, error) {
+	err := a.waitUntilWatched(t, &corev1.PersistentVolumeClaim{}, namespace, a.Timeout, func(ctx context.Context) (bool, error) {
 		pvc = &corev1.PersistentVolumeClaim{}
 		err := a.Client.Get(ctx, test.NamespacedName(namespace, name), pvc)
 		if err != nil {
@@ -1631,13 +1486,13 @@ func (a *MemberAwaitility) WaitUntilPVCDeleted(t *testing.T, name, namespace str
 }
 
 // WaitForPods waits until "n" number of pods exist in the given namespace
-func (a *MemberAwaitility) WaitForPods(t *testing.T, namespace string, n int, criteria ...PodWaitCriterion) ([]corev1.Pod, error) {
+func (a *MemberAwaitility) WaitForPods(t testing.TB, namespace string, n int, criteria ...PodWaitCriterion) ([]corev1.Pod, error) {
 	t.Logf("waiting for Pods in namespace '%s' with matching criteria", namespace)
 	pods := make([]corev1.Pod, 0, n)
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &corev1.Pod{}, namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		pds := make([]corev1.Pod, 0, n)
 		foundPods := &corev1.PodList{}
-		if err := a.Client.List(context.TODO(), foundPods, client.InNamespace(namespace)); err != nil {
+		if err := a.Client.List(ctx, foundPods, client.InNamespace(namespace)); err != nil {
 			return false, err
 		}
 	pods:
@@ -1659,11 +1514,11 @@ func (a *MemberAwaitility) WaitForPods(t *testing.T, namespace string, n int, cr
 }
 
 // WaitUntilPodsDeleted waits until the pods are deleted from the given namespace
-func (a *MemberAwaitility) WaitUntilPodsDeleted(t *testing.T, namespace string, criteria ...PodWaitCriterion) error {
+func (a *MemberAwaitility) WaitUntilPodsDeleted(t testing.TB, namespace string, criteria ...PodWaitCriterion) error {
 	t.Logf("waiting until Pods with matching criteria in namespace '%s' are deleted", namespace)
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	return a.waitUntilWatched(t, &corev1.Pod{}, namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		foundPods := &corev1.PodList{}
-		if err := a.Client.List(context.TODO(), foundPods, &client.ListOptions{Namespace: namespace}); err != nil {
+		if err := a.Client.List(ctx, foundPods, &client.ListOptions{Namespace: namespace}); err != nil {
 			return false, err
 		}
 		if len(foundPods.Items) == 0 {
@@ -1680,11 +1535,11 @@ func (a *MemberAwaitility) WaitUntilPodsDeleted(t *testing.T, namespace string,
 }
 
 // WaitUntilPodDeleted waits until the pod with the given name is deleted from the given namespace
-func (a *MemberAwaitility) WaitUntilPodDeleted(t *testing.T, namespace, name string) error {
+func (a *MemberAwaitility) WaitUntilPodDeleted(t testing.TB, namespace, name string) error {
 	t.Logf("waiting until Pod '%s' in namespace '%s' is deleted", name, namespace)
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	return a.waitUntilWatched(t, &corev1.Pod{}, namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &corev1.Pod{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -1766,11 +1621,11 @@ func checkPriorityClass(pod *corev1.Pod, name string, priority int) bool {
 }
 
 // WaitUntilWebhookDeleted waits until the webhook app in member namespace is deleted (ie, is not found)
-func (a *MemberAwaitility) WaitUntilWebhookDeleted(t *testing.T) error {
+func (a *MemberAwaitility) WaitUntilWebhookDeleted(t testing.TB) error {
 	t.Logf("waiting until webhook member-operator-webhook in namespace '%s' is deleted", a.Namespace)
 	deployment := &appsv1.Deployment{}
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
-		if err := a.Client.Get(context.TODO(), test.NamespacedName(a.Namespace, "member-operator-webhook"), deployment); err != nil {
+	return a.poll(t, a.Timeout, func(ctx context.Context) (done bool, err error) {
+		if err := a.Client.Get(ctx, test.NamespacedName(a.Namespace, "member-operator-webhook"), deployment); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -1781,16 +1636,16 @@ func (a *MemberAwaitility) WaitUntilWebhookDeleted(t *testing.T) error {
 }
 
 // WaitUntilNamespaceDeleted waits until the namespace with the given name is deleted (ie, is not found)
-func (a *MemberAwaitility) WaitUntilNamespaceDeleted(t *testing.T, username, typeName string) error {
+func (a *MemberAwaitility) WaitUntilNamespaceDeleted(t testing.TB, username, typeName string) error {
 	t.Logf("waiting until namespace for user '%s' and type '%s' is deleted", username, typeName)
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	return a.poll(t, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		labels := map[string]string{
 			toolchainv1alpha1.SpaceLabelKey: username,
 			toolchainv1alpha1.TypeLabelKey:  typeName,
 		}
 		opts := client.MatchingLabels(labels)
 		namespaceList := &corev1.NamespaceList{}
-		if err := a.Client.List(context.TODO(), namespaceList, opts); err != nil {
+		if err := a.Client.List(ctx, namespaceList, opts); err != nil {
 			return false, err
 		}
 		if len(namespaceList.Items) < 1 {
@@ -1801,11 +1656,11 @@ func (a *MemberAwaitility) WaitUntilNamespaceDeleted(t *testing.T, username, typ
 }
 
 // WaitUntilSecretsDeleted waits until the secrets with the given labels are deleted (ie, is not found)
-func (a *MemberAwaitility) WaitUntilSecretsDeleted(t *testing.T, namespace string, labels client.MatchingLabels) error {
+func (a *MemberAwaitility) WaitUntilSecretsDeleted(t testing.TB, namespace string, labels client.MatchingLabels) error {
 	t.Logf("waiting until secrets with lables '%v' in namespace '%s' is deleted", labels, namespace)
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	return a.poll(t, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		secretList := &corev1.SecretList{}
-		if err := a.Client.List(context.TODO(), secretList, labels); err != nil {
+		if err := a.Client.List(ctx, secretList, labels); err != nil {
 			return false, err
 		}
 		if len(secretList.Items) < 1 {
@@ -1830,7 +1685,7 @@ func matchUserWaitCriterion(actual *userv1.User, criteria ...UserWaitCriterion)
 	return true
 }
 
-func (a *MemberAwaitility) printUserWaitCriterionDiffs(t *testing.T, actual *userv1.User, criteria ...UserWaitCriterion) {
+func (a *MemberAwaitility) printUserWaitCriterionDiffs(t testing.TB, actual *userv1.User, criteria ...UserWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find User\n")
@@ -1846,12 +1701,12 @@ func (a *MemberAwaitility) printUserWaitCriterionDiffs(t *testing.T, actual *use
 }
 
 // WaitForUser waits until there is a User with the given name available
-func (a *MemberAwaitility) WaitForUser(t *testing.T, name string, criteria ...UserWaitCriterion) (*userv1.User, error) {
+func (a *MemberAwaitility) WaitForUser(t testing.TB, name string, criteria ...UserWaitCriterion) (*userv1.User, error) {
 	t.Logf("waiting for User '%s'", name)
 	user := &userv1.User{}
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &userv1.User{}, "", a.Timeout, func(ctx context.Context) (done bool, err error) {
 		user = &userv1.User{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name}, user); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Name: name}, user); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -1912,12 +1767,12 @@ func matchIdentityWaitCriterion(actual *userv1.Identity, criteria ...IdentityWai
 }
 
 // WaitForIdentity waits until there is an Identity with the given name available
-func (a *MemberAwaitility) WaitForIdentity(t *testing.T, name string, criteria ...IdentityWaitCriterion) (*userv1.Identity, error) {
+func (a *MemberAwaitility) WaitForIdentity(t testing.TB, name string, criteria ...IdentityWaitCriterion) (*userv1.Identity, error) {
 	t.Logf("waiting for Identity '%s'", name)
 	identity := &userv1.Identity{}
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &userv1.Identity{}, "", a.Timeout, func(ctx context.Context) (done bool, err error) {
 		identity = &userv1.Identity{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name}, identity); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Name: name}, identity); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -1937,7 +1792,7 @@ func (a *MemberAwaitility) WaitForIdentity(t *testing.T, name string, criteria .
 	return identity, err
 }
 
-func (a *MemberAwaitility) printIdentities(t *testing.T, expectedName string) {
+func (a *MemberAwaitility) printIdentities(t testing.TB, expectedName string) {
 	buf := &strings.Builder{}
 	fmt.Fprintf(buf, "failed to find Identity '%s'\n", expectedName)
 	fmt.Fprint(buf, a.listAndReturnContent("Identity", "", &userv1.IdentityList{}))
@@ -1957,11 +1812,11 @@ func UntilIdentityHasLabel(key, value string) IdentityWaitCriterion {
 }
 
 // WaitUntilUserAccountDeleted waits until the UserAccount with the given name is not found
-func (a *MemberAwaitility) WaitUntilUserAccountDeleted(t *testing.T, name string) error {
+func (a *MemberAwaitility) WaitUntilUserAccountDeleted(t testing.TB, name string) error {
 	t.Logf("waiting until UserAccount '%s' in namespace '%s' is deleted", name, a.Namespace)
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	return a.waitUntilWatched(t, &toolchainv1alpha1.UserAccount{}, a.Namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		ua := &toolchainv1alpha1.UserAccount{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Namespace: a.Namespace, Name: name}, ua); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Namespace: a.Namespace, Name: name}, ua); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -1972,11 +1827,11 @@ func (a *MemberAwaitility) WaitUntilUserAccountDeleted(t *testing.T, name string
 }
 
 // WaitUntilUserDeleted waits until the User with the given name is not found
-func (a *MemberAwaitility) WaitUntilUserDeleted(t *testing.T, name string) error {
+func (a *MemberAwaitility) WaitUntilUserDeleted(t testing.TB, name string) error {
 	t.Logf("waiting until User is deleted '%s'", name)
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	return a.waitUntilWatched(t, &userv1.User{}, "", a.Timeout, func(ctx context.Context) (done bool, err error) {
 		user := &userv1.User{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name}, user); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Name: name}, user); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -1990,11 +1845,11 @@ func (a *MemberAwaitility) WaitUntilUserDeleted(t *testing.T, name string) error
 }
 
 // WaitUntilIdentityDeleted waits until the Identity with the given name is not found
-func (a *MemberAwaitility) WaitUntilIdentityDeleted(t *testing.T, name string) error {
+func (a *MemberAwaitility) WaitUntilIdentityDeleted(t testing.TB, name string) error {
 	t.Logf("waiting until Identity is deleted '%s'", name)
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	return a.waitUntilWatched(t, &userv1.Identity{}, "", a.Timeout, func(ctx context.Context) (done bool, err error) {
 		identity := &userv1.Identity{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{Name: name}, identity); err != nil {
+		if err := a.Client.Get(ctx, types.NamespacedName{Name: name}, identity); err != nil {
 			if errors.IsNotFound(err) {
 				return true, nil
 			}
@@ -2008,7 +1863,7 @@ func (a *MemberAwaitility) WaitUntilIdentityDeleted(t *testing.T, name string) e
 }
 
 // GetConsoleURL retrieves Web Console Route and returns its URL
-func (a *MemberAwaitility) GetConsoleURL(t *testing.T) string {
+func (a *MemberAwaitility) GetConsoleURL(t testing.TB) string {
 	route := &routev1.Route{}
 	namespacedName := types.NamespacedName{Namespace: "openshift-console", Name: "console"}
 	err := a.Client.Get(context.TODO(), namespacedName, route)
@@ -2017,15 +1872,15 @@ func (a *MemberAwaitility) GetConsoleURL(t *testing.T) string {
 }
 
 // WaitUntilClusterResourceQuotasDeleted waits until all ClusterResourceQuotas with the given owner label are deleted (ie, none is found)
-func (a *MemberAwaitility) WaitUntilClusterResourceQuotasDeleted(t *testing.T, username string) error {
+func (a *MemberAwaitility) WaitUntilClusterResourceQuotasDeleted(t testing.TB, username string) error {
 	t.Logf("waiting for deletion of ClusterResourceQuotas for user '%s'", username)
-	return wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	return a.poll(t, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		labels := map[string]string{
 			toolchainv1alpha1.SpaceLabelKey: username,
 		}
 		opts := client.MatchingLabels(labels)
 		quotaList := &quotav1.ClusterResourceQuotaList{}
-		if err := a.Client.List(context.TODO(), quotaList, opts); err != nil {
+		if err := a.Client.List(ctx, quotaList, opts); err != nil {
 			return false, err
 		}
 		if len(quotaList.Items) == 0 {
@@ -2035,44 +1890,6 @@ func (a *MemberAwaitility) WaitUntilClusterResourceQuotasDeleted(t *testing.T, u
 	})
 }
 
-// MemberStatusWaitCriterion a struct to compare with a given MemberStatus
-type MemberStatusWaitCriterion struct {
-	Match func(*toolchainv1alpha1.MemberStatus) bool
-	Diff  func(*toolchainv1alpha1.MemberStatus) string
-}
-
-func matchMemberStatusWaitCriterion(actual *toolchainv1alpha1.MemberStatus, criteria ...MemberStatusWaitCriterion) bool {
-	for _, c := range criteria {
-		if !c.Match(actual) {
-			return false
-		}
-	}
-	return true
-}
-
-func (a *MemberAwaitility) printMemberStatusWaitCriterionDiffs(t *testing.T, actual *toolchainv1alpha1.MemberStatus, criteria ...MemberStatusWaitCriterion) {
-	buf := &strings.Builder{}
-	if actual == nil {
-		buf.WriteString("failed to find MemberStatus\n")
-		buf.WriteString(a.listAndReturnContent("MemberStatus", "", &toolchainv1alpha1.MemberStatusList{}))
-		buf.WriteString(a.listAndReturnContent("ToolchainCluster", "", &toolchainv1alpha1.ToolchainClusterList{}))
-	} else {
-		buf.WriteString("failed to find MemberStatus with matching criteria:\n")
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "actual:")
-		y, _ := StringifyObject(actual)
-		fmt.Fprintln(buf, string(y))
-		fmt.Fprintln(buf, "----")
-		fmt.Fprintln(buf, "diffs:")
-		for _, c := range criteria {
-			if !c.Match(actual) {
-				fmt.Fprintln(buf, c.Diff(actual))
-			}
-		}
-	}
-	t.Log(buf.String())
-}
-
 // UntilMemberStatusHasConditions returns a `MemberStatusWaitCriterion` which checks that the given
 // MemberStatus has exactly all the given status conditions
 func UntilMemberStatusHasConditions(expected ...toolchainv1alpha1.Condition) MemberStatusWaitCriterion {
@@ -2125,15 +1942,15 @@ func UntilMemberStatusHasConsoleURLSet(expectedURL string, expectedCondition too
 }
 
 // WaitForMemberStatus waits until the MemberStatus is available with the provided criteria, if any
-func (a *MemberAwaitility) WaitForMemberStatus(t *testing.T, criteria ...MemberStatusWaitCriterion) error {
+func (a *MemberAwaitility) WaitForMemberStatus(t testing.TB, criteria ...MemberStatusWaitCriterion) error {
 	name := "toolchain-member-status"
 	t.Logf("waiting for MemberStatus '%s' to match criteria", name)
 	// there should only be one member status with the name toolchain-member-status
 	var memberStatus *toolchainv1alpha1.MemberStatus
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, 2*a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.MemberStatus{}, a.Namespace, 2*a.Timeout, func(ctx context.Context) (done bool, err error) {
 		// retrieve the memberstatus from the member namespace
 		obj := &toolchainv1alpha1.MemberStatus{}
-		err = a.Client.Get(context.TODO(),
+		err = a.Client.Get(ctx,
 			types.NamespacedName{
 				Namespace: a.Namespace,
 				Name:      name,
@@ -2146,16 +1963,19 @@ func (a *MemberAwaitility) WaitForMemberStatus(t *testing.T, criteria ...MemberS
 			return false, err
 		}
 		memberStatus = obj
-		return matchMemberStatusWaitCriterion(obj, criteria...), nil
+		return matchWaitCriteria(obj, criteria...), nil
 	})
 	if err != nil {
-		a.printMemberStatusWaitCriterionDiffs(t, memberStatus, criteria...)
+		// include also all MemberStatuses and ToolchainClusters, to help troubleshooting
+		a.listAndPrint(t, "MemberStatuses", "", &toolchainv1alpha1.MemberStatusList{})
+		a.listAndPrint(t, "ToolchainClusters", "", &toolchainv1alpha1.ToolchainClusterList{})
+		printWaitCriteriaDiffs(t, "MemberStatus", memberStatus, criteria...)
 	}
 	return err
 }
 
 // GetMemberOperatorConfig returns MemberOperatorConfig instance, nil if not found
-func (a *MemberAwaitility) GetMemberOperatorConfig(t *testing.T) *toolchainv1alpha1.MemberOperatorConfig {
+func (a *MemberAwaitility) GetMemberOperatorConfig(t testing.TB) *toolchainv1alpha1.MemberOperatorConfig {
 	config := &toolchainv1alpha1.MemberOperatorConfig{}
 	if err := a.Client.Get(context.TODO(), test.NamespacedName(a.Namespace, "config"), config); err != nil {
 		if errors.IsNotFound(err) {
@@ -2178,15 +1998,15 @@ func UntilMemberConfigMatches(expectedMemberOperatorConfigSpec toolchainv1alpha1
 }
 
 // WaitForMemberOperatorConfig waits until the MemberOperatorConfig is available with the provided criteria, if any
-func (a *MemberAwaitility) WaitForMemberOperatorConfig(t *testing.T, hostAwait *HostAwaitility, criteria ...MemberOperatorConfigWaitCriterion) (*toolchainv1alpha1.MemberOperatorConfig, error) {
+func (a *MemberAwaitility) WaitForMemberOperatorConfig(t testing.TB, hostAwait *HostAwaitility, criteria ...MemberOperatorConfigWaitCriterion) (*toolchainv1alpha1.MemberOperatorConfig, error) {
 	// there should only be one MemberOperatorConfig with the name config
 	name := "config"
 	t.Logf("waiting for MemberOperatorConfig '%s'", name)
 	memberOperatorConfig := &toolchainv1alpha1.MemberOperatorConfig{}
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, 2*a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &toolchainv1alpha1.MemberOperatorConfig{}, a.Namespace, 2*a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &toolchainv1alpha1.MemberOperatorConfig{}
 		// retrieve the MemberOperatorConfig from the member namespace
-		err = a.Client.Get(context.TODO(),
+		err = a.Client.Get(ctx,
 			types.NamespacedName{
 				Namespace: a.Namespace,
 				Name:      name,
@@ -2224,7 +2044,20 @@ func (a *MemberAwaitility) GetMemberOperatorPod() (corev1.Pod, error) {
 	return pods.Items[0], nil
 }
 
-func (a *MemberAwaitility) WaitForMemberWebhooks(t *testing.T, image string) {
+// WaitForPodLogLine waits until the given pod logs a line matching the regular expression since the given time, and returns that line.
+// The logs of the member operator pod returned by GetMemberOperatorPod are streamed when no pod is given.
+func (a *MemberAwaitility) WaitForPodLogLine(t testing.TB, pod *corev1.Pod, regex *regexp.Regexp, since time.Time) (string, error) {
+	if pod == nil {
+		operatorPod, err := a.GetMemberOperatorPod()
+		if err != nil {
+			return "", err
+		}
+		pod = &operatorPod
+	}
+	return a.waitForPodLogLine(t, *pod, regex, since)
+}
+
+func (a *MemberAwaitility) WaitForMemberWebhooks(t testing.TB, image string) {
 	a.waitForUsersPodPriorityClass(t)
 	a.waitForService(t)
 	a.waitForWebhookDeployment(t, image)
@@ -2233,7 +2066,7 @@ func (a *MemberAwaitility) WaitForMemberWebhooks(t *testing.T, image string) {
 	a.verifyValidatingWebhookConfig(t, ca)
 }
 
-func (a *MemberAwaitility) waitForUsersPodPriorityClass(t *testing.T) {
+func (a *MemberAwaitility) waitForUsersPodPriorityClass(t testing.TB) {
 	t.Logf("checking PrioritiyClass resource '%s'", "sandbox-users-pods")
 	actualPrioClass := &schedulingv1.PriorityClass{}
 	a.waitForResource(t, "", "sandbox-users-pods", actualPrioClass)
@@ -2243,9 +2076,9 @@ func (a *MemberAwaitility) waitForUsersPodPriorityClass(t *testing.T) {
 	assert.Equal(t, "Priority class for pods in users' namespaces", actualPrioClass.Description)
 }
 
-func (a *MemberAwaitility) waitForResource(t *testing.T, namespace, name string, object client.Object) {
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
-		if err := a.Client.Get(context.TODO(), test.NamespacedName(namespace, name), object); err != nil {
+func (a *MemberAwaitility) waitForResource(t testing.TB, namespace, name string, object client.Object) {
+	err := a.poll(t, a.Timeout, func(ctx context.Context) (done bool, err error) {
+		if err := a.Client.Get(ctx, test.NamespacedName(namespace, name), object); err != nil {
 			if errors.IsNotFound(err) {
 				return false, nil
 			}
@@ -2256,7 +2089,7 @@ func (a *MemberAwaitility) waitForResource(t *testing.T, namespace, name string,
 	require.NoError(t, err)
 }
 
-func (a *MemberAwaitility) waitForService(t *testing.T) {
+func (a *MemberAwaitility) waitForService(t testing.TB) {
 	t.Logf("waiting for Service '%s' in namespace '%s'", "member-operator-webhook", a.Namespace)
 	actualService := &corev1.Service{}
 	a.waitForResource(t, a.Namespace, "member-operator-webhook", actualService)
@@ -2272,7 +2105,7 @@ func (a *MemberAwaitility) waitForService(t *testing.T) {
 	assert.Equal(t, appMemberOperatorWebhookLabel, actualService.Spec.Selector)
 }
 
-func (a *MemberAwaitility) waitForWebhookDeployment(t *testing.T, image string) {
+func (a *MemberAwaitility) waitForWebhookDeployment(t testing.TB, image string) {
 	t.Logf("checking Deployment '%s' in namespace '%s'", "member-operator-webhook", a.Namespace)
 	actualDeployment := a.WaitForDeploymentToGetReady(t, "member-operator-webhook", 3,
 		DeploymentHasContainerWithImage("mutator", image))
@@ -2304,7 +2137,7 @@ func (a *MemberAwaitility) waitForWebhookDeployment(t *testing.T, image string)
 	a.WaitForDeploymentToGetReady(t, "member-operator-webhook", 3)
 }
 
-func (a *MemberAwaitility) verifySecret(t *testing.T) []byte {
+func (a *MemberAwaitility) verifySecret(t testing.TB) []byte {
 	t.Logf("checking Secret '%s' in namespace '%s'", "webhook-certs", a.Namespace)
 	secret := &corev1.Secret{}
 	a.waitForResource(t, a.Namespace, "webhook-certs", secret)
@@ -2315,7 +2148,7 @@ func (a *MemberAwaitility) verifySecret(t *testing.T) []byte {
 	return ca
 }
 
-func (a *MemberAwaitility) verifyMutatingWebhookConfig(t *testing.T, ca []byte) {
+func (a *MemberAwaitility) verifyMutatingWebhookConfig(t testing.TB, ca []byte) {
 	t.Logf("checking MutatingWebhookConfiguration")
 	actualMutWbhConf := &admv1.MutatingWebhookConfiguration{}
 	a.waitForResource(t, "", "member-operator-webhook-"+a.Namespace, actualMutWbhConf)
@@ -2367,34 +2200,33 @@ func (a *MemberAwaitility) verifyMutatingWebhookConfig(t *testing.T, ca []byte)
 		},
 	}
 	for k, tc := range tests {
-		t.Run(k, func(t *testing.T) {
-			webhook := actualMutWbhConf.Webhooks[tc.Index]
-			assert.Equal(t, tc.Name, webhook.Name)
-			assert.Equal(t, []string{"v1"}, webhook.AdmissionReviewVersions)
-			assert.Equal(t, admv1.SideEffectClassNone, *webhook.SideEffects)
-			assert.Equal(t, int32(5), *webhook.TimeoutSeconds)
-			assert.Equal(t, admv1.NeverReinvocationPolicy, *webhook.ReinvocationPolicy)
-			assert.Equal(t, tc.FailurePolicy, *webhook.FailurePolicy)
-			assert.Equal(t, admv1.Equivalent, *webhook.MatchPolicy)
-			assert.Equal(t, codereadyToolchainProviderLabel, webhook.NamespaceSelector.MatchLabels)
-			assert.Equal(t, ca, webhook.ClientConfig.CABundle)
-			assert.Equal(t, "member-operator-webhook", webhook.ClientConfig.Service.Name)
-			assert.Equal(t, a.Namespace, webhook.ClientConfig.Service.Namespace)
-			assert.Equal(t, tc.Path, *webhook.ClientConfig.Service.Path)
-			assert.Equal(t, int32(443), *webhook.ClientConfig.Service.Port)
-			require.Len(t, webhook.Rules, 1)
-
-			rule := webhook.Rules[0]
-			assert.Equal(t, tc.Rule.Operations, rule.Operations)
-			assert.Equal(t, tc.Rule.APIGroups, rule.APIGroups)
-			assert.Equal(t, tc.Rule.APIVersions, rule.APIVersions)
-			assert.Equal(t, tc.Rule.Resources, rule.Resources)
-			assert.Equal(t, admv1.NamespacedScope, *rule.Scope)
-		})
-	}
-}
-
-func (a *MemberAwaitility) verifyValidatingWebhookConfig(t *testing.T, ca []byte) {
+		t.Logf("checking the '%s' mutating webhook", k)
+		webhook := actualMutWbhConf.Webhooks[tc.Index]
+		assert.Equal(t, tc.Name, webhook.Name)
+		assert.Equal(t, []string{"v1"}, webhook.AdmissionReviewVersions)
+		assert.Equal(t, admv1.SideEffectClassNone, *webhook.SideEffects)
+		assert.Equal(t, int32(5), *webhook.TimeoutSeconds)
+		assert.Equal(t, admv1.NeverReinvocationPolicy, *webhook.ReinvocationPolicy)
+		assert.Equal(t, tc.FailurePolicy, *webhook.FailurePolicy)
+		assert.Equal(t, admv1.Equivalent, *webhook.MatchPolicy)
+		assert.Equal(t, codereadyToolchainProviderLabel, webhook.NamespaceSelector.MatchLabels)
+		assert.Equal(t, ca, webhook.ClientConfig.CABundle)
+		assert.Equal(t, "member-operator-webhook", webhook.ClientConfig.Service.Name)
+		assert.Equal(t, a.Namespace, webhook.ClientConfig.Service.Namespace)
+		assert.Equal(t, tc.Path, *webhook.ClientConfig.Service.Path)
+		assert.Equal(t, int32(443), *webhook.ClientConfig.Service.Port)
+		require.Len(t, webhook.Rules, 1)
+
+		rule := webhook.Rules[0]
+		assert.Equal(t, tc.Rule.Operations, rule.Operations)
+		assert.Equal(t, tc.Rule.APIGroups, rule.APIGroups)
+		assert.Equal(t, tc.Rule.APIVersions, rule.APIVersions)
+		assert.Equal(t, tc.Rule.Resources, rule.Resources)
+		assert.Equal(t, admv1.NamespacedScope, *rule.Scope)
+	}
+}
+
+func (a *MemberAwaitility) verifyValidatingWebhookConfig(t testing.TB, ca []byte) {
 	t.Logf("checking ValidatingWebhookConfiguration '%s'", "member-operator-validating-webhook"+a.Namespace)
 	actualValWbhConf := &admv1.ValidatingWebhookConfiguration{}
 	a.waitForResource(t, "", "member-operator-validating-webhook-"+a.Namespace, actualValWbhConf)
@@ -2494,12 +2326,12 @@ func (a *MemberAwaitility) verifyValidatingWebhookConfig(t *testing.T, ca []byte
 	assert.Equal(t, admv1.NamespacedScope, *vmrequestRule.Scope)
 }
 
-func (a *MemberAwaitility) WaitForAutoscalingBufferApp(t *testing.T) {
+func (a *MemberAwaitility) WaitForAutoscalingBufferApp(t testing.TB) {
 	a.verifyAutoscalingBufferPriorityClass(t)
 	a.verifyAutoscalingBufferDeployment(t)
 }
 
-func (a *MemberAwaitility) verifyAutoscalingBufferPriorityClass(t *testing.T) {
+func (a *MemberAwaitility) verifyAutoscalingBufferPriorityClass(t testing.TB) {
 	t.Logf("checking PrioritiyClass '%s'", "member-operator-autoscaling-buffer")
 	actualPrioClass := &schedulingv1.PriorityClass{}
 	a.waitForResource(t, "", "member-operator-autoscaling-buffer", actualPrioClass)
@@ -2511,11 +2343,11 @@ func (a *MemberAwaitility) verifyAutoscalingBufferPriorityClass(t *testing.T) {
 }
 
 // WaitForDeployment waits until the Deployment with the given name is available with the provided criteria, if any
-func (a *MemberAwaitility) waitForAutoscalingBufferDeployment(t *testing.T, criteria ...DeploymentCriteria) *appsv1.Deployment {
+func (a *MemberAwaitility) waitForAutoscalingBufferDeployment(t testing.TB, criteria ...DeploymentCriteria) *appsv1.Deployment {
 	return a.WaitForDeploymentToGetReady(t, "autoscaling-buffer", 2, criteria...)
 }
 
-func (a *MemberAwaitility) verifyAutoscalingBufferDeployment(t *testing.T) {
+func (a *MemberAwaitility) verifyAutoscalingBufferDeployment(t testing.TB) {
 	t.Logf("checking Deployment '%s' in namespace '%s'", "autoscaling-buffer", a.Namespace)
 	expectedMemory, err := resource.ParseQuantity("50Mi")
 	require.NoError(t, err)
@@ -2554,8 +2386,8 @@ func (a *MemberAwaitility) verifyAutoscalingBufferDeployment(t *testing.T) {
 }
 
 // WaitForExpectedNumberOfResources waits until the number of resources matches the expected count
-func (a *MemberAwaitility) WaitForExpectedNumberOfResources(t *testing.T, namespace, kind string, expected int, list func() (int, error)) error {
-	if actual, err := a.waitForExpectedNumberOfResources(expected, list); err != nil {
+func (a *MemberAwaitility) WaitForExpectedNumberOfResources(t testing.TB, namespace, kind string, expected int, list func() (int, error)) error {
+	if actual, err := a.waitForExpectedNumberOfResources(t, expected, list); err != nil {
 		t.Logf("expected number of resources of kind '%s' in namespace '%s' to be %d but it was %d", kind, namespace, expected, actual)
 		return err
 	}
@@ -2563,17 +2395,17 @@ func (a *MemberAwaitility) WaitForExpectedNumberOfResources(t *testing.T, namesp
 }
 
 // WaitForExpectedNumberOfClusterResources waits until the number of resources matches the expected count
-func (a *MemberAwaitility) WaitForExpectedNumberOfClusterResources(t *testing.T, kind string, expected int, list func() (int, error)) error {
-	if actual, err := a.waitForExpectedNumberOfResources(expected, list); err != nil {
+func (a *MemberAwaitility) WaitForExpectedNumberOfClusterResources(t testing.TB, kind string, expected int, list func() (int, error)) error {
+	if actual, err := a.waitForExpectedNumberOfResources(t, expected, list); err != nil {
 		t.Logf("expected number of resources of kind '%s' to be %d but it was %d", kind, expected, actual)
 		return err
 	}
 	return nil
 }
 
-func (a *MemberAwaitility) waitForExpectedNumberOfResources(expected int, list func() (int, error)) (int, error) {
+func (a *MemberAwaitility) waitForExpectedNumberOfResources(t testing.TB, expected int, list func() (int, error)) (int, error) {
 	var actual int
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.poll(t, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		a, err := list()
 		if err != nil {
 			return false, err
@@ -2584,12 +2416,12 @@ func (a *MemberAwaitility) waitForExpectedNumberOfResources(expected int, list f
 	return actual, err
 }
 
-func (a *MemberAwaitility) WaitForEnvironment(t *testing.T, namespace, name string, criteria ...LabelWaitCriterion) (*appstudiov1.Environment, error) {
+func (a *MemberAwaitility) WaitForEnvironment(t testing.TB, namespace, name string, criteria ...LabelWaitCriterion) (*appstudiov1.Environment, error) {
 	t.Logf("waiting for Environment resource '%s' to exist in namespace '%s'", name, namespace)
 	var env *appstudiov1.Environment
-	err := wait.PollUntilContextTimeout(context.TODO(), a.RetryInterval, a.Timeout, true, func(ctx context.Context) (done bool, err error) {
+	err := a.waitUntilWatched(t, &appstudiov1.Environment{}, namespace, a.Timeout, func(ctx context.Context) (done bool, err error) {
 		obj := &appstudiov1.Environment{}
-		if err := a.Client.Get(context.TODO(), types.NamespacedName{
+		if err := a.Client.Get(ctx, types.NamespacedName{
 			Namespace: namespace,
 			Name:      name},
 			obj); errors.IsNotFound(err) {
@@ -2609,7 +2441,7 @@ func (a *MemberAwaitility) WaitForEnvironment(t *testing.T, namespace, name stri
 	return env, err
 }
 
-func (a *MemberAwaitility) printEnvironmentWaitCriterionDiffs(t *testing.T, actual *appstudiov1.Environment, criteria ...LabelWaitCriterion) {
+func (a *MemberAwaitility) printEnvironmentWaitCriterionDiffs(t testing.TB, actual *appstudiov1.Environment, criteria ...LabelWaitCriterion) {
 	buf := &strings.Builder{}
 	if actual == nil {
 		buf.WriteString("failed to find Environment\n")
@@ -2631,7 +2463,7 @@ func (a *MemberAwaitility) printEnvironmentWaitCriterionDiffs(t *testing.T, actu
 	t.Log(buf.String())
 }
 
-func (a *MemberAwaitility) GetContainerEnv(t *testing.T, name string) string {
+func (a *MemberAwaitility) GetContainerEnv(t testing.TB, name string) string {
 	deployment := a.WaitForDeploymentToGetReady(t, "member-operator-controller-manager", 1)
 	var value string
 containers:
@@ -2648,7 +2480,7 @@ containers:
 	return value
 }
 
-func (a *MemberAwaitility) WaitForToolchainClusterResources(t *testing.T) {
+func (a *MemberAwaitility) WaitForToolchainClusterResources(t testing.TB) {
 	t.Logf("checking ToolchainCluster Resources")
 	actualSA := &corev1.ServiceAccount{}
 	a.waitForResource(t, a.Namespace, "toolchaincluster-member", actualSA)
@@ -0,0 +1,349 @@
+package wait
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// Recorder records every revision of a set of objects while a test is running, so that the order in which the objects
+// changed can be analyzed when the test fails. Its timeline is logged and written in `$ARTIFACT_DIR/<test name>/`,
+// along with its JSON version, if the test fails.
+//
+// Usage:
+//
+//	wait.NewRecorder(t).
+//		Record(hostAwait.Awaitility, &toolchainv1alpha1.UserSignup{ObjectMeta: metav1.ObjectMeta{Namespace: hostAwait.Namespace, Name: name}}).
+//		Record(memberAwait.Awaitility, &toolchainv1alpha1.NSTemplateSet{ObjectMeta: metav1.ObjectMeta{Namespace: memberAwait.Namespace, Name: name}})
+type Recorder struct {
+	t testing.TB
+
+	mu        sync.Mutex
+	revisions []Revision
+	latest    map[string]*unstructured.Unstructured
+	stops     []func()
+}
+
+// Revision is a change of a recorded object
+type Revision struct {
+	Time            time.Time         `json:"time"`
+	Cluster         string            `json:"cluster"`
+	Kind            string            `json:"kind"`
+	Namespace       string            `json:"namespace,omitempty"`
+	Name            string            `json:"name"`
+	Event           string            `json:"event"`
+	ResourceVersion string            `json:"resourceVersion"`
+	Finalizers      []string          `json:"finalizers,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty"`
+	// ConditionTransitions describes the conditions which were added, changed or removed by the revision
+	ConditionTransitions []string `json:"conditionTransitions,omitempty"`
+	// Diff is the difference with the previous revision of the object, in YAML
+	Diff string `json:"diff,omitempty"`
+}
+
+// NewRecorder returns a new Recorder which stops recording when the test is done
+func NewRecorder(t testing.TB) *Recorder {
+	r := &Recorder{
+		t:      t,
+		latest: map[string]*unstructured.Unstructured{},
+	}
+	t.Cleanup(func() {
+		r.stop()
+		if t.Failed() {
+			r.write()
+		}
+	})
+	return r
+}
+
+// Record starts recording the revisions of the objects with the same kind, namespace and name as the given ones in the
+// cluster of the awaitility. If the name of an object is empty, then all the objects of its kind in its namespace are recorded.
+func (r *Recorder) Record(a *Awaitility, objects ...client.Object) *Recorder {
+	for _, obj := range objects {
+		gvk, err := apiutil.GVKForObject(obj, a.Client.Scheme())
+		if err != nil {
+			r.t.Logf("unable to record the history of object '%s': %s", client.ObjectKeyFromObject(obj), err)
+			continue
+		}
+		namespace, name := obj.GetNamespace(), obj.GetName()
+		observe := func(event string, o client.Object) {
+			if name == "" || o.GetName() == name {
+				r.observe(a.ClusterName, gvk.Kind, event, o)
+			}
+		}
+		watched := obj.DeepCopyObject().(client.Object)
+		if kw := a.watch(watched, namespace); kw != nil {
+			r.recordWatched(a, kw, watched, observe)
+			continue
+		}
+		// the kind can't be watched, poll the objects instead until the test is done
+		ctx, cancel := context.WithCancel(context.Background())
+		r.addStop(cancel)
+		go r.pollUntilDone(ctx, a, watched, observe)
+	}
+	return r
+}
+
+// recordWatched passes the events of the watch to the observe function, along with the objects which existed before the observer
+// was added. If the informer of the watch fails to sync, then the objects are polled instead until the test is done.
+func (r *Recorder) recordWatched(a *Awaitility, kw *kindWatch, watched client.Object, observe func(event string, obj client.Object)) {
+	removeObserver := kw.addObserver(observe)
+	r.addStop(removeObserver)
+	// the objects may have been created before the informer existed, or before this observer was added
+	r.poll(a, watched, observe)
+	ctx, cancel := context.WithCancel(context.Background())
+	r.addStop(cancel)
+	go func() {
+		select {
+		case <-ctx.Done():
+			return
+		case <-kw.settled:
+		}
+		if kw.failed() {
+			// the informer was removed, so no more events will be received
+			removeObserver()
+			r.pollUntilDone(ctx, a, watched, observe)
+		}
+	}()
+}
+
+// pollUntilDone polls the objects of the kind of the given object every RetryInterval until the context is done
+func (r *Recorder) pollUntilDone(ctx context.Context, a *Awaitility, watched client.Object, observe func(event string, obj client.Object)) {
+	_ = wait.PollUntilContextCancel(ctx, a.RetryInterval, true, func(context.Context) (bool, error) {
+		r.poll(a, watched, observe)
+		return false, nil
+	})
+}
+
+// poll gets the latest version of the objects of the kind of the given object, and passes them to the observe function
+func (r *Recorder) poll(a *Awaitility, watched client.Object, observe func(event string, obj client.Object)) {
+	gvk, err := apiutil.GVKForObject(watched, a.Client.Scheme())
+	if err != nil {
+		return
+	}
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk)
+	var opts []client.ListOption
+	if watched.GetNamespace() != "" {
+		opts = append(opts, client.InNamespace(watched.GetNamespace()))
+	}
+	if err := a.Client.List(context.TODO(), list, opts...); err != nil {
+		return
+	}
+	seen := map[string]bool{}
+	for i := range list.Items {
+		seen[r.key(a.ClusterName, gvk.Kind, list.Items[i].GetNamespace(), list.Items[i].GetName())] = true
+		observe(modifiedEvent, &list.Items[i])
+	}
+	// the objects which are not listed anymore were deleted
+	prefix := fmt.Sprintf("%s/%s/", a.ClusterName, gvk.Kind)
+	if watched.GetNamespace() != "" {
+		prefix += watched.GetNamespace() + "/"
+	}
+	r.mu.Lock()
+	var deleted []*unstructured.Unstructured
+	for key, obj := range r.latest {
+		if strings.HasPrefix(key, prefix) && !seen[key] {
+			deleted = append(deleted, obj)
+		}
+	}
+	r.mu.Unlock()
+	for _, obj := range deleted {
+		observe(deletedEvent, obj)
+	}
+}
+
+func (r *Recorder) addStop(stop func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stops = append(r.stops, stop)
+}
+
+func (r *Recorder) stop() {
+	r.mu.Lock()
+	stops := r.stops
+	r.stops = nil
+	r.mu.Unlock()
+	for _, stop := range stops {
+		stop()
+	}
+}
+
+func (r *Recorder) key(cluster, kind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", cluster, kind, namespace, name)
+}
+
+// observe records a new revision if the object changed since the previous one
+func (r *Recorder) observe(cluster, kind, event string, obj client.Object) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return
+	}
+	current := &unstructured.Unstructured{Object: content}
+	key := r.key(cluster, kind, current.GetNamespace(), current.GetName())
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	previous, exists := r.latest[key]
+	switch {
+	case event == deletedEvent:
+		if !exists {
+			return
+		}
+		delete(r.latest, key)
+	case exists && previous.GetResourceVersion() == current.GetResourceVersion():
+		// nothing changed (eg, the object was polled)
+		return
+	case !exists:
+		event = addedEvent
+		r.latest[key] = current
+	default:
+		event = modifiedEvent
+		r.latest[key] = current
+	}
+
+	revision := Revision{
+		Time:            time.Now(),
+		Cluster:         cluster,
+		Kind:            kind,
+		Namespace:       current.GetNamespace(),
+		Name:            current.GetName(),
+		Event:           event,
+		ResourceVersion: current.GetResourceVersion(),
+		Finalizers:      current.GetFinalizers(),
+		Labels:          current.GetLabels(),
+	}
+	if event != deletedEvent {
+		revision.ConditionTransitions = conditionTransitions(previous, current)
+		if previous != nil {
+			revision.Diff = revisionDiff(previous, current)
+		}
+	}
+	r.revisions = append(r.revisions, revision)
+}
+
+// conditionTransitions describes the conditions which were added, changed or removed between the two versions of the object
+func conditionTransitions(previous, current *unstructured.Unstructured) []string {
+	before := conditions(previous)
+	after := conditions(current)
+	var transitions []string
+	for t, c := range after {
+		if b, ok := before[t]; !ok {
+			transitions = append(transitions, fmt.Sprintf("%s: -> %s", t, c))
+		} else if b != c {
+			transitions = append(transitions, fmt.Sprintf("%s: %s -> %s", t, b, c))
+		}
+	}
+	for t, b := range before {
+		if _, ok := after[t]; !ok {
+			transitions = append(transitions, fmt.Sprintf("%s: %s -> removed", t, b))
+		}
+	}
+	sort.Strings(transitions)
+	return transitions
+}
+
+// conditions returns the status and reason of the conditions of the object, by type
+func conditions(obj *unstructured.Unstructured) map[string]string {
+	result := map[string]string{}
+	if obj == nil {
+		return result
+	}
+	conds, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conds {
+		cond, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		state := fmt.Sprintf("%v", cond["status"])
+		if reason, ok := cond["reason"]; ok && reason != "" {
+			state = fmt.Sprintf("%s (%v)", state, reason)
+		}
+		result[fmt.Sprintf("%v", cond["type"])] = state
+	}
+	return result
+}
+
+// revisionDiff returns the difference between the YAML of the two versions of the object, without the resource version
+func revisionDiff(previous, current *unstructured.Unstructured) string {
+	before, after := previous.DeepCopy(), current.DeepCopy()
+	unstructured.RemoveNestedField(before.Object, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(after.Object, "metadata", "resourceVersion")
+	b, err := StringifyObject(before)
+	if err != nil {
+		return ""
+	}
+	a, err := StringifyObject(after)
+	if err != nil {
+		return ""
+	}
+	return cmp.Diff(string(b), string(a))
+}
+
+// Revisions returns the revisions recorded so far, in chronological order
+func (r *Recorder) Revisions() []Revision {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Revision{}, r.revisions...)
+}
+
+// Timeline renders the revisions recorded so far, in chronological order
+func (r *Recorder) Timeline() string {
+	sb := strings.Builder{}
+	for _, rev := range r.Revisions() {
+		fmt.Fprintf(&sb, "%s %s %s %s/%s %s (resourceVersion: %s)\n", rev.Time.Format("15:04:05.000"), rev.Cluster, rev.Kind,
+			rev.Namespace, rev.Name, rev.Event, rev.ResourceVersion)
+		if len(rev.Finalizers) > 0 {
+			fmt.Fprintf(&sb, "  finalizers: %s\n", strings.Join(rev.Finalizers, ", "))
+		}
+		if len(rev.Labels) > 0 {
+			labels := make([]string, 0, len(rev.Labels))
+			for k, v := range rev.Labels {
+				labels = append(labels, k+"="+v)
+			}
+			sort.Strings(labels)
+			fmt.Fprintf(&sb, "  labels: %s\n", strings.Join(labels, ", "))
+		}
+		for _, transition := range rev.ConditionTransitions {
+			fmt.Fprintf(&sb, "  condition %s\n", transition)
+		}
+		if rev.Diff != "" {
+			fmt.Fprintf(&sb, "  diff (-previous +current):\n")
+			for _, line := range strings.Split(strings.TrimRight(rev.Diff, "\n"), "\n") {
+				fmt.Fprintf(&sb, "    %s\n", line)
+			}
+		}
+	}
+	return sb.String()
+}
+
+// write logs the timeline and writes it in the artifact directory of the test, along with its JSON version
+func (r *Recorder) write() {
+	timeline := r.Timeline()
+	r.t.Logf("history of the recorded objects:\n%s", timeline)
+
+	c := &diagnosticsCollector{
+		t:   r.t,
+		dir: filepath.Join(artifactDir(), sanitizeFileName(r.t.Name())),
+	}
+	c.write("history.log", []byte(timeline))
+	content, err := json.MarshalIndent(r.Revisions(), "", "  ")
+	if err != nil {
+		r.t.Logf("unable to render the history of the recorded objects in JSON: %s", err)
+		return
+	}
+	c.write("history.json", content)
+}
@@ -0,0 +1,148 @@
+package wait
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestRecorder(t *testing.T) {
+	// given
+	r := NewRecorder(t)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "pod", ResourceVersion: "1"},
+	}
+	ready := pod.DeepCopy()
+	ready.ResourceVersion = "2"
+	ready.Finalizers = []string{"test/finalizer"}
+	ready.Labels = map[string]string{"ready": "true"}
+	ready.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue, Reason: "Started"}}
+
+	// when
+	r.observe("host", "Pod", addedEvent, pod)
+	r.observe("host", "Pod", modifiedEvent, pod) // same resource version, not recorded
+	r.observe("host", "Pod", modifiedEvent, ready)
+	r.observe("host", "Pod", deletedEvent, ready)
+	r.observe("host", "Pod", deletedEvent, ready) // already deleted, not recorded
+
+	// then
+	revisions := r.Revisions()
+	require.Len(t, revisions, 3)
+	assert.Equal(t, addedEvent, revisions[0].Event)
+	assert.Empty(t, revisions[0].ConditionTransitions)
+	assert.Empty(t, revisions[0].Diff)
+
+	assert.Equal(t, modifiedEvent, revisions[1].Event)
+	assert.Equal(t, "2", revisions[1].ResourceVersion)
+	assert.Equal(t, []string{"test/finalizer"}, revisions[1].Finalizers)
+	assert.Equal(t, map[string]string{"ready": "true"}, revisions[1].Labels)
+	assert.Equal(t, []string{"Ready: -> True (Started)"}, revisions[1].ConditionTransitions)
+	assert.Contains(t, revisions[1].Diff, "test/finalizer")
+	assert.NotContains(t, revisions[1].Diff, "resourceVersion")
+
+	assert.Equal(t, deletedEvent, revisions[2].Event)
+
+	timeline := r.Timeline()
+	assert.Contains(t, timeline, "host Pod test/pod Added (resourceVersion: 1)")
+	assert.Contains(t, timeline, "host Pod test/pod Modified (resourceVersion: 2)")
+	assert.Contains(t, timeline, "  condition Ready: -> True (Started)")
+	assert.Contains(t, timeline, "  finalizers: test/finalizer")
+	assert.Contains(t, timeline, "  labels: ready=true")
+	assert.Contains(t, timeline, "host Pod test/pod Deleted (resourceVersion: 2)")
+}
+
+func TestRecordWatched(t *testing.T) {
+	// newKindWatch returns a watch which is not backed by an informer
+	newKindWatch := func() *kindWatch {
+		return &kindWatch{
+			settled:     make(chan struct{}),
+			subscribers: map[chan struct{}]struct{}{},
+			observers:   map[*observer]struct{}{},
+		}
+	}
+	observers := func(kw *kindWatch) int {
+		kw.mu.Lock()
+		defer kw.mu.Unlock()
+		return len(kw.observers)
+	}
+
+	t.Run("informer synced", func(t *testing.T) {
+		// given
+		a := newTestAwaitility(t, interceptor.Funcs{}, newConfigMap("operator", "existing", nil))
+		r := NewRecorder(t)
+		kw := newKindWatch()
+		observe := func(event string, obj client.Object) { r.observe("host", "ConfigMap", event, obj) }
+
+		// when
+		r.recordWatched(a, kw, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "operator"}}, observe)
+		kw.state.Store(synced)
+		close(kw.settled)
+
+		// then
+		require.Len(t, r.Revisions(), 1) // the object which existed before the observer was added
+		assert.Equal(t, "existing", r.Revisions()[0].Name)
+		assert.Never(t, func() bool { return observers(kw) == 0 }, 50*time.Millisecond, time.Millisecond)
+	})
+
+	t.Run("informer failed to sync", func(t *testing.T) {
+		// given
+		a := newTestAwaitility(t, interceptor.Funcs{}, newConfigMap("operator", "existing", nil))
+		r := NewRecorder(t)
+		kw := newKindWatch()
+		observe := func(event string, obj client.Object) { r.observe("host", "ConfigMap", event, obj) }
+		r.recordWatched(a, kw, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "operator"}}, observe)
+
+		// when
+		kw.state.Store(failed)
+		close(kw.settled)
+		require.NoError(t, a.Client.Create(context.TODO(), newConfigMap("operator", "created", nil)))
+
+		// then
+		assert.Eventually(t, func() bool { return observers(kw) == 0 }, time.Second, time.Millisecond)
+		assert.Eventually(t, func() bool {
+			for _, rev := range r.Revisions() {
+				if rev.Name == "created" {
+					return true
+				}
+			}
+			return false
+		}, time.Second, time.Millisecond)
+	})
+}
+
+func TestConditionTransitions(t *testing.T) {
+	// given
+	previous := &corev1.Pod{Status: corev1.PodStatus{Conditions: []corev1.PodCondition{
+		{Type: corev1.PodReady, Status: corev1.ConditionFalse, Reason: "Starting"},
+		{Type: corev1.PodScheduled, Status: corev1.ConditionTrue},
+	}}}
+	current := &corev1.Pod{Status: corev1.PodStatus{Conditions: []corev1.PodCondition{
+		{Type: corev1.PodReady, Status: corev1.ConditionTrue, Reason: "Started"},
+		{Type: corev1.ContainersReady, Status: corev1.ConditionTrue},
+	}}}
+
+	// when
+	transitions := conditionTransitions(toUnstructured(t, previous), toUnstructured(t, current))
+
+	// then
+	assert.Equal(t, []string{
+		"ContainersReady: -> True",
+		"PodScheduled: True -> removed",
+		"Ready: False (Starting) -> True (Started)",
+	}, transitions)
+}
+
+func toUnstructured(t *testing.T, obj *corev1.Pod) *unstructured.Unstructured {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: content}
+}
@@ -0,0 +1,243 @@
+package wait
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// RunIDVar is the name of the env var which contains the ID of the run, so that the test packages executed by the same `make`
+	// invocation share the same ID. A new ID is generated by each test package when it's not set
+	RunIDVar = "E2E_RUN_ID"
+
+	// RunIDLabelKey is the label set on the objects created by the tests, with the ID of the run
+	RunIDLabelKey = "e2e.toolchain.dev.openshift.com/run-id"
+	// TestNameLabelKey is the label set on the objects created by the tests, with the name of the test (truncated and
+	// sanitized to be a valid label value)
+	TestNameLabelKey = "e2e.toolchain.dev.openshift.com/test"
+	// TestNameAnnotationKey is the annotation set on the objects created by the tests, with the full name of the test
+	TestNameAnnotationKey = "e2e.toolchain.dev.openshift.com/test"
+)
+
+var runID = sync.OnceValue(newRunID)
+
+func newRunID() string {
+	if id := os.Getenv(RunIDVar); id != "" {
+		return id
+	}
+	suffix := make([]byte, 3)
+	_, _ = rand.Read(suffix)
+	return fmt.Sprintf("%s-%s", time.Now().UTC().Format("20060102-150405"), hex.EncodeToString(suffix))
+}
+
+// RunID returns the ID of the current run
+func RunID() string {
+	return runID()
+}
+
+// StampRun sets the labels with the ID of the current run and the name of the test on the given object, so that it can be
+// swept if the run is aborted before the object is deleted (see SweepStaleRuns)
+func StampRun(t testing.TB, obj client.Object) {
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[RunIDLabelKey] = RunID()
+	if t != nil {
+		labels[TestNameLabelKey] = testNameLabelValue(t.Name())
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[TestNameAnnotationKey] = t.Name()
+		obj.SetAnnotations(annotations)
+	}
+	obj.SetLabels(labels)
+}
+
+var invalidLabelValueChars = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+// testNameLabelValue converts the name of the test into a valid label value
+func testNameLabelValue(name string) string {
+	value := invalidLabelValueChars.ReplaceAllString(name, "_")
+	if len(value) > 63 {
+		value = value[:63]
+	}
+	return strings.Trim(value, "_.-")
+}
+
+// the ranks of the kinds in which the objects are swept: all the objects of a rank are deleted before the ones of the next rank.
+// The kinds which are not listed are swept along with the `defaultSweepRank`.
+var sweepRanks = map[string]int{
+	"SpaceBindingRequest": 0,
+	"SpaceRequest":        0,
+	"SpaceBinding":        1,
+	"subspace":            2,
+	"Space":               3,
+	"UserSignup":          4,
+	"MasterUserRecord":    5,
+	"NSTemplateTier":      7,
+	"TierTemplate":        8,
+	"Namespace":           9,
+}
+
+const defaultSweepRank = 6
+
+func sweepRank(kind string, obj metav1.PartialObjectMetadata) int {
+	if kind == "Space" && obj.Labels[toolchainv1alpha1.ParentSpaceLabelKey] != "" {
+		kind = "subspace"
+	}
+	if rank, ok := sweepRanks[kind]; ok {
+		return rank
+	}
+	return defaultSweepRank
+}
+
+// staleObject is an object created by a stale run, in a given cluster
+type staleObject struct {
+	await *Awaitility
+	gvk   schema.GroupVersionKind
+	obj   metav1.PartialObjectMetadata
+}
+
+func (o staleObject) String() string {
+	name := o.obj.Name
+	if o.obj.Namespace != "" {
+		name = o.obj.Namespace + "/" + name
+	}
+	return fmt.Sprintf("%s %s in %s (run: %s, test: %s)", o.gvk.Kind, name, o.await.ClusterName, o.obj.Labels[RunIDLabelKey], o.obj.Annotations[TestNameAnnotationKey])
+}
+
+// SweepStaleRuns deletes the objects of the host and member clusters which were created by other runs than the current one
+// (see StampRun) more than `olderThan` ago. The objects are deleted in dependency order (eg, the SpaceBindings before the
+// Spaces, the subspaces before their parent Spaces and the UserSignups before the namespaces), waiting for the deletion of the
+// objects of a kind before deleting the ones which they depend on.
+func SweepStaleRuns(t testing.TB, awaitilities Awaitilities, olderThan time.Duration) {
+	var stale []staleObject
+	for _, a := range distinctClusters(awaitilities) {
+		objects, err := a.listStaleObjects(olderThan)
+		require.NoError(t, err)
+		stale = append(stale, objects...)
+	}
+	byRank := map[int][]staleObject{}
+	for _, o := range stale {
+		rank := sweepRank(o.gvk.Kind, o.obj)
+		byRank[rank] = append(byRank[rank], o)
+	}
+	ranks := make([]int, 0, len(byRank))
+	for rank := range byRank {
+		ranks = append(ranks, rank)
+	}
+	sort.Ints(ranks)
+	t.Logf("found %d objects created by stale runs", len(stale))
+
+	for _, rank := range ranks {
+		objects := byRank[rank]
+		for _, o := range objects {
+			t.Logf("deleting %s", o)
+			obj := o.obj.DeepCopy()
+			obj.SetGroupVersionKind(o.gvk)
+			if err := o.await.Client.Delete(context.TODO(), obj, client.PropagationPolicy(metav1.DeletePropagationForeground)); err != nil && !apierrors.IsNotFound(err) {
+				require.NoError(t, err, "failed to delete %s", o)
+			}
+		}
+		for _, o := range objects {
+			err := o.await.poll(t, o.await.Timeout, func(ctx context.Context) (done bool, err error) {
+				obj := &metav1.PartialObjectMetadata{}
+				obj.SetGroupVersionKind(o.gvk)
+				if err := o.await.Client.Get(ctx, client.ObjectKeyFromObject(&o.obj), obj); err != nil {
+					if apierrors.IsNotFound(err) {
+						return true, nil
+					}
+					return false, err
+				}
+				return false, nil
+			})
+			require.NoError(t, err, "%s was not deleted", o)
+		}
+	}
+}
+
+// distinctClusters returns the awaitilities of the host and of the members which don't run in the same cluster as the host
+// or as another member
+func distinctClusters(awaitilities Awaitilities) []*Awaitility {
+	all := []*Awaitility{awaitilities.Host().Awaitility}
+	for _, m := range awaitilities.AllMembers() {
+		all = append(all, m.Awaitility)
+	}
+	var distinct []*Awaitility
+	hosts := map[string]bool{}
+	for _, a := range all {
+		if hosts[a.RestConfig.Host] {
+			continue
+		}
+		hosts[a.RestConfig.Host] = true
+		distinct = append(distinct, a)
+	}
+	return distinct
+}
+
+// listStaleObjects returns the objects of the cluster with a run ID label which is not the one of the current run, and which
+// were created more than `olderThan` ago
+func (a *Awaitility) listStaleObjects(olderThan time.Duration) ([]staleObject, error) {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(a.RestConfig)
+	if err != nil {
+		return nil, err
+	}
+	// the errors of the groups which can't be discovered (eg, because of an unavailable aggregated API) are ignored
+	resources, err := discoveryClient.ServerPreferredResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+	resources = discovery.FilteredBy(discovery.SupportsAllVerbs{Verbs: []string{"list", "delete"}}, resources)
+	selector := client.HasLabels{RunIDLabelKey}
+	var stale []staleObject
+	for _, group := range resources {
+		gv, err := schema.ParseGroupVersion(group.GroupVersion)
+		if err != nil {
+			return nil, err
+		}
+		seen := sets.New[string]()
+		for _, resource := range group.APIResources {
+			// skip the subresources and the kinds which are served by several resources
+			if strings.Contains(resource.Name, "/") || seen.Has(resource.Kind) {
+				continue
+			}
+			seen.Insert(resource.Kind)
+			list := &metav1.PartialObjectMetadataList{}
+			list.SetGroupVersionKind(gv.WithKind(resource.Kind + "List"))
+			if err := a.Client.List(context.TODO(), list, selector); err != nil {
+				if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) || meta.IsNoMatchError(err) {
+					continue
+				}
+				return nil, fmt.Errorf("failed to list the %s objects: %w", resource.Kind, err)
+			}
+			for _, obj := range list.Items {
+				if obj.Labels[RunIDLabelKey] == RunID() || time.Since(obj.CreationTimestamp.Time) < olderThan {
+					continue
+				}
+				stale = append(stale, staleObject{await: a, gvk: gv.WithKind(resource.Kind), obj: obj})
+			}
+		}
+	}
+	return stale, nil
+}
@@ -0,0 +1,92 @@
+package wait
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+func TestStampRun(t *testing.T) {
+	t.Run("with test", func(t *testing.T) {
+		// given
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config", Labels: map[string]string{"app": "e2e"}}}
+
+		// when
+		StampRun(t, cm)
+
+		// then
+		assert.Equal(t, map[string]string{
+			"app":            "e2e",
+			RunIDLabelKey:    RunID(),
+			TestNameLabelKey: "TestStampRun_with_test",
+		}, cm.Labels)
+		assert.Equal(t, map[string]string{TestNameAnnotationKey: "TestStampRun/with_test"}, cm.Annotations)
+	})
+
+	t.Run("without test", func(t *testing.T) {
+		// given
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config"}}
+
+		// when
+		StampRun(nil, cm)
+
+		// then
+		assert.Equal(t, map[string]string{RunIDLabelKey: RunID()}, cm.Labels)
+		assert.Empty(t, cm.Annotations)
+	})
+}
+
+func TestRunIDFromEnv(t *testing.T) {
+	// given
+	t.Setenv(RunIDVar, "my-run")
+	defer func(previous func() string) {
+		runID = previous
+	}(runID)
+	runID = sync.OnceValue(newRunID)
+
+	// when
+	id := RunID()
+
+	// then
+	assert.Equal(t, "my-run", id)
+}
+
+func TestTestNameLabelValue(t *testing.T) {
+	for name, expected := range map[string]string{
+		"TestFoo":                            "TestFoo",
+		"TestFoo/bar baz":                    "TestFoo_bar_baz",
+		"TestFoo/#01":                        "TestFoo_01",
+		"TestFoo/" + strings.Repeat("a", 60): "TestFoo_" + strings.Repeat("a", 55),
+		"TestFoo/" + strings.Repeat("a", 54) + "/b": "TestFoo_" + strings.Repeat("a", 54),
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			value := testNameLabelValue(name)
+
+			// then
+			assert.Equal(t, expected, value)
+			assert.Empty(t, validation.IsValidLabelValue(value))
+		})
+	}
+}
+
+func TestSweepRank(t *testing.T) {
+	// given
+	subspace := metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{toolchainv1alpha1.ParentSpaceLabelKey: "parent"}}}
+	none := metav1.PartialObjectMetadata{}
+
+	// then
+	assert.Less(t, sweepRank("SpaceBindingRequest", none), sweepRank("SpaceBinding", none))
+	assert.Less(t, sweepRank("SpaceBinding", none), sweepRank("Space", subspace))
+	assert.Less(t, sweepRank("Space", subspace), sweepRank("Space", none))
+	assert.Less(t, sweepRank("Space", none), sweepRank("UserSignup", none))
+	assert.Less(t, sweepRank("UserSignup", none), sweepRank("ConfigMap", none))
+	assert.Less(t, sweepRank("ConfigMap", none), sweepRank("NSTemplateTier", none))
+	assert.Less(t, sweepRank("NSTemplateTier", none), sweepRank("Namespace", none))
+}
@@ -0,0 +1,306 @@
+package wait
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"text/tabwriter"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+const (
+	// WaitReportOTLPVar is the name of the env var which, when set to `true`, makes ReportWaits also export the waits
+	// as OpenTelemetry spans in a file using the OTLP JSON encoding
+	WaitReportOTLPVar = "WAIT_REPORT_OTLP"
+
+	// waitReportTopN is the number of rows of the table of the slowest waits printed at the end of the run
+	waitReportTopN = 20
+)
+
+// the outcomes of the waits
+const (
+	SucceededOutcome = "succeeded"
+	TimedOutOutcome  = "timed out"
+	CanceledOutcome  = "canceled"
+	FailedOutcome    = "failed"
+)
+
+// waitsPackage is the import path of this package, used to find the callers of the waits in the stack
+var waitsPackage = reflect.TypeOf(Awaitility{}).PkgPath()
+
+// moduleRoot is the directory of the module, used to shorten the paths of the callers of the waits
+var moduleRoot = func() string {
+	_, file, _, _ := runtime.Caller(0)
+	return strings.TrimSuffix(filepath.Dir(file), "/testsupport/wait")
+}()
+
+// telemetry contains the waits which were done so far by the tests of the package
+var telemetry = struct {
+	sync.Mutex
+	waits []WaitRecord
+}{}
+
+// WaitRecord describes a wait done by a test
+type WaitRecord struct {
+	// Function is the function of this package called by the test (eg, `(*HostAwaitility).WaitForUserSignup`)
+	Function string `json:"function"`
+	// Caller is the location of the call to the function (eg, `test/e2e/parallel/user_workloads_test.go:42`)
+	Caller   string        `json:"caller"`
+	Test     string        `json:"test,omitempty"`
+	Cluster  string        `json:"cluster,omitempty"`
+	Kind     string        `json:"kind,omitempty"`
+	Start    time.Time     `json:"start"`
+	Duration time.Duration `json:"duration"`
+	// Polls is the number of times the condition of the wait was evaluated
+	Polls   int    `json:"polls"`
+	Outcome string `json:"outcome"`
+}
+
+// measurement records the duration, number of evaluations and outcome of a wait
+type measurement struct {
+	record WaitRecord
+	polls  atomic.Int32
+}
+
+// measure starts the measurement of a wait on objects of the same kind as the given one (which can be nil if the kind is unknown)
+func (a *Awaitility) measure(t testing.TB, watched client.Object) *measurement {
+	m := &measurement{
+		record: WaitRecord{
+			Cluster: a.ClusterName,
+			Start:   time.Now(),
+		},
+	}
+	m.record.Function, m.record.Caller = caller()
+	if t != nil {
+		m.record.Test = t.Name()
+	}
+	if watched != nil && a.Client != nil {
+		if gvk, err := apiutil.GVKForObject(watched, a.Client.Scheme()); err == nil {
+			m.record.Kind = gvk.Kind
+		}
+	}
+	return m
+}
+
+// counting returns the condition which counts its evaluations
+func (m *measurement) counting(condition wait.ConditionWithContextFunc) wait.ConditionWithContextFunc {
+	return func(ctx context.Context) (bool, error) {
+		m.polls.Add(1)
+		return condition(ctx)
+	}
+}
+
+// done records the wait with the given result
+func (m *measurement) done(err error) {
+	m.record.Duration = time.Since(m.record.Start)
+	m.record.Polls = int(m.polls.Load())
+	m.record.Outcome = outcome(err)
+	telemetry.Lock()
+	defer telemetry.Unlock()
+	telemetry.waits = append(telemetry.waits, m.record)
+}
+
+// caller returns the outermost function of this package in the stack, and the location from where it was called
+func caller() (string, string) {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	function := ""
+	for {
+		frame, more := frames.Next()
+		name, inPackage := strings.CutPrefix(frame.Function, waitsPackage+".")
+		if !inPackage {
+			if function == "" {
+				return "", ""
+			}
+			return function, fmt.Sprintf("%s:%d", relativePath(frame.File), frame.Line)
+		}
+		function = name
+		if !more {
+			return function, ""
+		}
+	}
+}
+
+// relativePath returns the path of the file relative to the root of the module, if it's in the module
+func relativePath(file string) string {
+	return strings.TrimPrefix(file, moduleRoot+"/")
+}
+
+func outcome(err error) string {
+	switch {
+	case err == nil:
+		return SucceededOutcome
+	case errors.Is(err, context.DeadlineExceeded):
+		return TimedOutOutcome
+	case errors.Is(err, context.Canceled):
+		return CanceledOutcome
+	default:
+		return FailedOutcome
+	}
+}
+
+// WaitRecords returns the waits which were done so far by the tests of the package
+func WaitRecords() []WaitRecord {
+	telemetry.Lock()
+	defer telemetry.Unlock()
+	return append([]WaitRecord{}, telemetry.waits...)
+}
+
+// ReportWaits writes the report of the waits done by the tests of the package in `$ARTIFACT_DIR/wait-report-<suite>.json`,
+// then prints the table of the slowest waits. The waits are also exported as OpenTelemetry spans in
+// `$ARTIFACT_DIR/wait-spans-<suite>.json` if the WAIT_REPORT_OTLP env var is set to `true`.
+// It is meant to be called once the tests are over (see testsupport.RunSuite).
+func ReportWaits(suite string) {
+	waits := WaitRecords()
+	if len(waits) == 0 {
+		return
+	}
+	content, err := json.MarshalIndent(waits, "", "  ")
+	if err == nil {
+		err = WriteArtifact(fmt.Sprintf("wait-report-%s.json", suite), content)
+	}
+	if err != nil {
+		fmt.Printf("unable to write the wait report: %s\n", err)
+	}
+	if os.Getenv(WaitReportOTLPVar) == "true" {
+		content, err := json.Marshal(otlpTraces(suite, waits))
+		if err == nil {
+			err = WriteArtifact(fmt.Sprintf("wait-spans-%s.json", suite), content)
+		}
+		if err != nil {
+			fmt.Printf("unable to write the wait spans: %s\n", err)
+		}
+	}
+	fmt.Printf("slowest waits of the '%s' suite:\n", suite)
+	PrintWaitSummary(os.Stdout, waits, waitReportTopN)
+}
+
+// WriteArtifact writes the given content in the file with the given name in the artifacts directory (see ARTIFACT_DIR)
+func WriteArtifact(name string, content []byte) error {
+	if err := os.MkdirAll(artifactDir(), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(artifactDir(), name), content, 0o600)
+}
+
+// WaitSummary aggregates the waits done with the same function on the same kind of objects
+type WaitSummary struct {
+	Function string
+	Kind     string
+	Count    int
+	Failures int
+	Polls    int
+	Total    time.Duration
+	Max      time.Duration
+}
+
+// SummarizeWaits aggregates the waits by function and kind, from the longest total duration to the shortest
+func SummarizeWaits(waits []WaitRecord) []WaitSummary {
+	byKey := map[string]*WaitSummary{}
+	for _, w := range waits {
+		key := w.Function + "/" + w.Kind
+		s, ok := byKey[key]
+		if !ok {
+			s = &WaitSummary{Function: w.Function, Kind: w.Kind}
+			byKey[key] = s
+		}
+		s.Count++
+		if w.Outcome != SucceededOutcome {
+			s.Failures++
+		}
+		s.Polls += w.Polls
+		s.Total += w.Duration
+		s.Max = max(s.Max, w.Duration)
+	}
+	summaries := make([]WaitSummary, 0, len(byKey))
+	for _, s := range byKey {
+		summaries = append(summaries, *s)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Total != summaries[j].Total {
+			return summaries[i].Total > summaries[j].Total
+		}
+		return summaries[i].Function+summaries[i].Kind < summaries[j].Function+summaries[j].Kind
+	})
+	return summaries
+}
+
+// PrintWaitSummary prints the table of the top N waits with the longest total duration
+func PrintWaitSummary(out io.Writer, waits []WaitRecord, topN int) {
+	summaries := SummarizeWaits(waits)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "FUNCTION\tKIND\tCOUNT\tFAILURES\tPOLLS\tTOTAL\tAVERAGE\tMAX")
+	for i, s := range summaries {
+		if i == topN {
+			break
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%s\t%s\t%s\n", s.Function, s.Kind, s.Count, s.Failures, s.Polls,
+			s.Total.Round(time.Millisecond), (s.Total / time.Duration(s.Count)).Round(time.Millisecond), s.Max.Round(time.Millisecond))
+	}
+	_ = w.Flush()
+}
+
+// otlpTraces converts the waits into OpenTelemetry spans in the OTLP JSON encoding, with a trace per test, so that they can be loaded
+// by any tool supporting the OTLP file exporter format (eg, the `otlpjsonfile` receiver of the OpenTelemetry collector)
+func otlpTraces(suite string, waits []WaitRecord) map[string]any {
+	spans := make([]map[string]any, 0, len(waits))
+	for _, w := range waits {
+		traceID := sha256.Sum256([]byte(suite + "/" + w.Test))
+		spanID := make([]byte, 8)
+		_, _ = rand.Read(spanID)
+		status := map[string]any{"code": 1} // OK
+		if w.Outcome != SucceededOutcome {
+			status = map[string]any{"code": 2, "message": w.Outcome} // ERROR
+		}
+		spans = append(spans, map[string]any{
+			"traceId":           hex.EncodeToString(traceID[:16]),
+			"spanId":            hex.EncodeToString(spanID),
+			"name":              w.Function,
+			"kind":              1, // INTERNAL
+			"startTimeUnixNano": fmt.Sprint(w.Start.UnixNano()),
+			"endTimeUnixNano":   fmt.Sprint(w.Start.Add(w.Duration).UnixNano()),
+			"attributes": []map[string]any{
+				otlpAttribute("code.caller", w.Caller),
+				otlpAttribute("test.name", w.Test),
+				otlpAttribute("k8s.cluster.name", w.Cluster),
+				otlpAttribute("k8s.kind", w.Kind),
+				otlpAttribute("wait.outcome", w.Outcome),
+				{"key": "wait.polls", "value": map[string]any{"intValue": fmt.Sprint(w.Polls)}},
+			},
+			"status": status,
+		})
+	}
+	return map[string]any{
+		"resourceSpans": []map[string]any{{
+			"resource": map[string]any{
+				"attributes": []map[string]any{otlpAttribute("service.name", "toolchain-e2e-"+suite)},
+			},
+			"scopeSpans": []map[string]any{{
+				"scope": map[string]any{"name": waitsPackage},
+				"spans": spans,
+			}},
+		}},
+	}
+}
+
+func otlpAttribute(key, value string) map[string]any {
+	return map[string]any{"key": key, "value": map[string]any{"stringValue": value}}
+}
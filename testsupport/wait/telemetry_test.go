@@ -0,0 +1,78 @@
+package wait
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeasure(t *testing.T) {
+	// given
+	a := &Awaitility{ClusterName: "host", RetryInterval: time.Millisecond}
+	count := 0
+
+	// when
+	err := a.poll(t, time.Second, func(_ context.Context) (bool, error) {
+		count++
+		return count == 3, nil
+	})
+	_ = a.poll(t, 10*time.Millisecond, func(_ context.Context) (bool, error) {
+		return false, nil
+	})
+	_ = a.poll(t, time.Second, func(_ context.Context) (bool, error) {
+		return false, errors.New("mock error")
+	})
+
+	// then
+	require.NoError(t, err)
+	var records []WaitRecord
+	for _, r := range WaitRecords() {
+		if r.Test == t.Name() {
+			records = append(records, r)
+		}
+	}
+	require.Len(t, records, 3)
+	assert.Equal(t, "TestMeasure", records[0].Function)
+	assert.Equal(t, "host", records[0].Cluster)
+	assert.Equal(t, 3, records[0].Polls)
+	assert.Equal(t, SucceededOutcome, records[0].Outcome)
+	assert.Equal(t, TimedOutOutcome, records[1].Outcome)
+	assert.GreaterOrEqual(t, records[1].Duration, 10*time.Millisecond)
+	assert.Equal(t, FailedOutcome, records[2].Outcome)
+	assert.Equal(t, 1, records[2].Polls)
+}
+
+func TestSummarizeWaits(t *testing.T) {
+	// given
+	waits := []WaitRecord{
+		{Function: "(*HostAwaitility).WaitForUserSignup", Kind: "UserSignup", Duration: time.Second, Polls: 2, Outcome: SucceededOutcome},
+		{Function: "(*HostAwaitility).WaitForUserSignup", Kind: "UserSignup", Duration: 3 * time.Second, Polls: 4, Outcome: TimedOutOutcome},
+		{Function: "(*MemberAwaitility).WaitForNamespace", Kind: "Namespace", Duration: 2 * time.Second, Polls: 1, Outcome: SucceededOutcome},
+	}
+
+	// when
+	summaries := SummarizeWaits(waits)
+
+	// then
+	assert.Equal(t, []WaitSummary{
+		{Function: "(*HostAwaitility).WaitForUserSignup", Kind: "UserSignup", Count: 2, Failures: 1, Polls: 6, Total: 4 * time.Second, Max: 3 * time.Second},
+		{Function: "(*MemberAwaitility).WaitForNamespace", Kind: "Namespace", Count: 1, Polls: 1, Total: 2 * time.Second, Max: 2 * time.Second},
+	}, summaries)
+
+	t.Run("print the top N", func(t *testing.T) {
+		// given
+		out := &bytes.Buffer{}
+
+		// when
+		PrintWaitSummary(out, waits, 1)
+
+		// then
+		assert.Contains(t, out.String(), "(*HostAwaitility).WaitForUserSignup  UserSignup  2      1         6      4s     2s       3s")
+		assert.NotContains(t, out.String(), "WaitForNamespace")
+	})
+}
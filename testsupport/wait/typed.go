@@ -0,0 +1,140 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+//go:generate go run ./internal/waitersgen -output zz_generated.waiters.go
+
+// WaitCriterion a struct to compare with an expected object of type T. The `<Kind>WaitCriterion` types of the toolchain kinds are
+// aliases of this type, generated along with the typed waiters (see TypedWaiter)
+type WaitCriterion[T client.Object] struct {
+	Match func(T) bool
+	// Diff describes why the object doesn't match (optional)
+	Diff func(T) string
+}
+
+func matchWaitCriteria[T client.Object](actual T, criteria ...WaitCriterion[T]) bool {
+	for _, c := range criteria {
+		if !c.Match(actual) {
+			return false
+		}
+	}
+	return true
+}
+
+// TypedWaiter waits for the objects of a toolchain kind. It is returned by the generated `For<Kind>` functions of the Awaitility,
+// eg: `hostAwait.ForSocialEvent(t).WithName(name, UntilSocialEventHasConditions(...))`
+type TypedWaiter[T client.Object] struct {
+	t         testing.TB
+	await     *Awaitility
+	kind      string
+	namespace string
+	newObject func() T
+}
+
+// newTypedWaiter returns a TypedWaiter for the objects of the given kind in the namespace of the awaitility, or in the whole
+// cluster if the kind is cluster-scoped
+func newTypedWaiter[T client.Object](t testing.TB, a *Awaitility, kind string, newObject func() T) *TypedWaiter[T] {
+	namespace := a.Namespace
+	namespaced, err := a.Client.IsObjectNamespaced(newObject())
+	require.NoError(t, err, "failed to determine if the %s kind is namespaced", kind)
+	if !namespaced {
+		namespace = ""
+	}
+	return &TypedWaiter[T]{
+		t:         t,
+		await:     a,
+		kind:      kind,
+		namespace: namespace,
+		newObject: newObject,
+	}
+}
+
+// InNamespace returns a copy of the waiter which looks up the objects in the given namespace instead of the namespace of the awaitility
+func (w *TypedWaiter[T]) InNamespace(namespace string) *TypedWaiter[T] {
+	result := *w
+	result.namespace = namespace
+	return &result
+}
+
+// WithName waits until there is an object with the given name which matches all the criteria, and returns it.
+// If there is no such object before the timeout, then the last version of the object and the diffs with the criteria
+// it doesn't match are printed.
+func (w *TypedWaiter[T]) WithName(name string, criteria ...WaitCriterion[T]) (T, error) {
+	w.t.Logf("waiting for %s '%s' in %s to match criteria", w.kind, name, w.scope())
+	var result T
+	found := false
+	err := w.await.waitUntilWatched(w.t, w.newObject(), w.namespace, w.await.Timeout, func(ctx context.Context) (done bool, err error) {
+		obj := w.newObject()
+		if err := w.await.Client.Get(ctx, client.ObjectKey{Namespace: w.namespace, Name: name}, obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		result = obj
+		found = true
+		return matchWaitCriteria(obj, criteria...), nil
+	})
+	// no match found, print the diffs
+	if err != nil {
+		w.printDiffs(name, found, result, criteria...)
+	}
+	return result, err
+}
+
+func (w *TypedWaiter[T]) printDiffs(name string, found bool, actual T, criteria ...WaitCriterion[T]) {
+	if !found {
+		w.t.Logf("failed to find %s '%s' in %s\n", w.kind, name, w.scope())
+		return
+	}
+	w.t.Log(waitCriteriaDiffs(fmt.Sprintf("%s '%s' in %s", w.kind, name, w.scope()), actual, criteria...))
+}
+
+// printWaitCriteriaDiffs prints the object of the given kind and the diffs with the criteria it doesn't match, or that it
+// wasn't found if it's nil
+func printWaitCriteriaDiffs[T client.Object](t testing.TB, kind string, actual T, criteria ...WaitCriterion[T]) {
+	if v := reflect.ValueOf(actual); !v.IsValid() || v.IsNil() {
+		t.Logf("failed to find %s\n", kind)
+		return
+	}
+	t.Log(waitCriteriaDiffs(kind, actual, criteria...))
+}
+
+func waitCriteriaDiffs[T client.Object](description string, actual T, criteria ...WaitCriterion[T]) string {
+	buf := &strings.Builder{}
+	fmt.Fprintf(buf, "failed to find %s with matching criteria:\n", description)
+	fmt.Fprintln(buf, "----")
+	fmt.Fprintln(buf, "actual:")
+	y, _ := StringifyObject(actual)
+	fmt.Fprintln(buf, string(y))
+	fmt.Fprintln(buf, "----")
+	fmt.Fprintln(buf, "diffs:")
+	for _, c := range criteria {
+		if !c.Match(actual) && c.Diff != nil {
+			fmt.Fprintln(buf, c.Diff(actual))
+		}
+	}
+	return buf.String()
+}
+
+// Deleted waits until the object with the given name is deleted
+func (w *TypedWaiter[T]) Deleted(name string) error {
+	return For(w.t, w.await, w.newObject()).InNamespace(w.namespace).WithNameDeleted(name)
+}
+
+func (w *TypedWaiter[T]) scope() string {
+	if w.namespace == "" {
+		return "the cluster"
+	}
+	return fmt.Sprintf("namespace '%s'", w.namespace)
+}
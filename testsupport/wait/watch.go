@@ -0,0 +1,346 @@
+package wait
+
+import (
+	"context"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+const (
+	// PollWaitStrategy is the value of the WaitStrategyVar env var which disables the watch-based waits
+	PollWaitStrategy = "poll"
+
+	// resyncInterval is the interval at which a condition which only reads the watched kind is re-evaluated when no event
+	// was received, in case an event was missed
+	resyncInterval = 2 * time.Second
+	// watchSyncTimeout is how long an informer can take to list the objects of its kind before the kind is considered as
+	// not watchable (eg, because the watch isn't permitted), in which case the waits fall back to polling
+	watchSyncTimeout = 30 * time.Second
+)
+
+// engines contains the watch engines of the clusters, by rest config. All the copies of an Awaitility share the same
+// rest config, hence the same informers
+var engines = struct {
+	sync.Mutex
+	byConfig map[*rest.Config]*watchEngine
+}{
+	byConfig: map[*rest.Config]*watchEngine{},
+}
+
+// watchEngine runs a single informer per GVK/namespace of a cluster, which is shared by all the waits on objects of that kind.
+// Only the cluster-scoped kinds and the kinds in the namespaces of the operators are watched: the informers are never stopped,
+// so watching the namespaces of the users (which are created and deleted by each test) would leave a list/watch running for
+// each of them until the end of the suite. The waits on the objects in the other namespaces poll the cluster instead.
+type watchEngine struct {
+	config *rest.Config
+	scheme *runtime.Scheme
+
+	mu         sync.Mutex
+	namespaces map[string]bool
+	caches     map[string]cache.Cache
+	kinds      map[watchKey]*kindWatch
+}
+
+type watchKey struct {
+	gvk          schema.GroupVersionKind
+	namespace    string
+	unstructured bool
+}
+
+const (
+	syncing int32 = iota
+	synced
+	failed
+)
+
+// kindWatch notifies its subscribers every time an object of its kind is added, updated or deleted
+type kindWatch struct {
+	cache cache.Cache
+	state atomic.Int32
+	// settled is closed once the informer has synced or has failed to
+	settled chan struct{}
+
+	mu          sync.Mutex
+	subscribers map[chan struct{}]struct{}
+	observers   map[*observer]struct{}
+}
+
+// observer receives the objects of the events of a kindWatch
+type observer struct {
+	observe func(event string, obj client.Object)
+}
+
+// the types of events passed to the observers
+const (
+	addedEvent    = "Added"
+	modifiedEvent = "Modified"
+	deletedEvent  = "Deleted"
+)
+
+// watchEngine returns the watch engine of the cluster, or nil if the waits should poll the cluster
+func (a *Awaitility) watchEngine() *watchEngine {
+	if a.RestConfig == nil || a.Client == nil || os.Getenv(WaitStrategyVar) == PollWaitStrategy {
+		return nil
+	}
+	engines.Lock()
+	defer engines.Unlock()
+	e, ok := engines.byConfig[a.RestConfig]
+	if !ok {
+		e = &watchEngine{
+			config:     a.RestConfig,
+			scheme:     a.Client.Scheme(),
+			namespaces: map[string]bool{},
+			caches:     map[string]cache.Cache{},
+			kinds:      map[watchKey]*kindWatch{},
+		}
+		engines.byConfig[a.RestConfig] = e
+	}
+	if a.Namespace != "" {
+		e.mu.Lock()
+		e.namespaces[a.Namespace] = true
+		e.mu.Unlock()
+	}
+	return e
+}
+
+// watch returns the watch of the kind of the given object in the given namespace (or the cluster-wide watch if the kind
+// is cluster-scoped), or nil if the kind can't be watched or if the namespace is not the one of an operator
+func (a *Awaitility) watch(obj client.Object, namespace string) *kindWatch {
+	e := a.watchEngine()
+	if e == nil {
+		return nil
+	}
+	namespaced, err := a.Client.IsObjectNamespaced(obj)
+	if err != nil || namespaced == (namespace == "") {
+		// watching a namespaced kind in all the namespaces would list all the objects of the cluster
+		return nil
+	}
+	return e.watch(obj, namespace)
+}
+
+func (e *watchEngine) watch(obj client.Object, namespace string) *kindWatch {
+	gvk, err := apiutil.GVKForObject(obj, e.scheme)
+	if err != nil {
+		return nil
+	}
+	_, isUnstructured := obj.(*unstructured.Unstructured)
+	key := watchKey{gvk: gvk, namespace: namespace, unstructured: isUnstructured}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if namespace != "" && !e.namespaces[namespace] {
+		return nil
+	}
+	if kw, ok := e.kinds[key]; ok {
+		// the kinds which can't be watched are remembered, so that the next waits poll them without trying again
+		if kw.state.Load() == failed {
+			return nil
+		}
+		return kw
+	}
+
+	kw := &kindWatch{
+		settled:     make(chan struct{}),
+		subscribers: map[chan struct{}]struct{}{},
+		observers:   map[*observer]struct{}{},
+	}
+	e.kinds[key] = kw
+	c, err := e.cache(namespace)
+	if err != nil {
+		kw.state.Store(failed)
+		return nil
+	}
+	kw.cache = c
+	informer, err := c.GetInformer(context.TODO(), obj, cache.BlockUntilSynced(false))
+	if err != nil {
+		kw.state.Store(failed)
+		return nil
+	}
+	if _, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj any) { kw.notify(addedEvent, obj) },
+		UpdateFunc: func(_, obj any) { kw.notify(modifiedEvent, obj) },
+		DeleteFunc: func(obj any) { kw.notify(deletedEvent, obj) },
+	}); err != nil {
+		kw.state.Store(failed)
+		_ = c.RemoveInformer(context.TODO(), obj)
+		return nil
+	}
+	go func() {
+		defer close(kw.settled)
+		ctx, cancel := context.WithTimeout(context.Background(), watchSyncTimeout)
+		defer cancel()
+		if !toolscache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+			// most probably, the objects of this kind can't be listed or watched: stop trying and keep polling instead
+			kw.state.Store(failed)
+			_ = c.RemoveInformer(context.Background(), obj)
+			return
+		}
+		kw.state.Store(synced)
+	}()
+	return kw
+}
+
+// cache returns the informer cache for the given namespace (an operator namespace, or the empty namespace for the cluster-scoped
+// kinds), which is started when it's first requested and runs until the end of the suite
+func (e *watchEngine) cache(namespace string) (cache.Cache, error) {
+	if c, ok := e.caches[namespace]; ok {
+		return c, nil
+	}
+	options := cache.Options{Scheme: e.scheme}
+	if namespace != "" {
+		options.DefaultNamespaces = map[string]cache.Config{namespace: {}}
+	}
+	c, err := cache.New(e.config, options)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		_ = c.Start(context.Background())
+	}()
+	e.caches[namespace] = c
+	return c, nil
+}
+
+func (kw *kindWatch) notify(event string, obj any) {
+	kw.mu.Lock()
+	defer kw.mu.Unlock()
+	for s := range kw.subscribers {
+		select {
+		case s <- struct{}{}:
+		default:
+			// the subscriber already has a pending notification
+		}
+	}
+	if deleted, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = deleted.Obj
+	}
+	if o, ok := obj.(client.Object); ok {
+		for obs := range kw.observers {
+			obs.observe(event, o)
+		}
+	}
+}
+
+// addObserver registers a function which receives the object of every event, along with the function to call to remove it
+func (kw *kindWatch) addObserver(observe func(event string, obj client.Object)) func() {
+	obs := &observer{observe: observe}
+	kw.mu.Lock()
+	defer kw.mu.Unlock()
+	kw.observers[obs] = struct{}{}
+	return func() {
+		kw.mu.Lock()
+		defer kw.mu.Unlock()
+		delete(kw.observers, obs)
+	}
+}
+
+// subscribe returns a channel which receives a notification every time an object of the kind changes, along with the function to
+// call to unsubscribe
+func (kw *kindWatch) subscribe() (<-chan struct{}, func()) {
+	s := make(chan struct{}, 1)
+	kw.mu.Lock()
+	defer kw.mu.Unlock()
+	kw.subscribers[s] = struct{}{}
+	return s, func() {
+		kw.mu.Lock()
+		defer kw.mu.Unlock()
+		delete(kw.subscribers, s)
+	}
+}
+
+func (kw *kindWatch) synced() bool {
+	return kw.state.Load() == synced
+}
+
+func (kw *kindWatch) failed() bool {
+	return kw.state.Load() == failed
+}
+
+// waitUntil waits until the condition is true, the timeout is reached or the test is done (see waitContext).
+//
+// The condition is evaluated immediately, then every time an object of the same kind as the watched one is added, updated or deleted
+// in the given namespace (or in the cluster if the kind is cluster-scoped), and at least every RetryInterval (or the current interval
+// of the Backoff of the kind) since it may read other objects. The evaluations are never closer than this interval, even if many
+// events are received. If the kind can't be watched or if the namespace isn't the one of an operator, then the condition is polled
+// instead.
+func (a *Awaitility) waitUntil(t testing.TB, watched client.Object, namespace string, timeout time.Duration, condition wait.ConditionWithContextFunc) error {
+	return a.waitForEvents(t, watched, namespace, timeout, false, condition)
+}
+
+// waitUntilWatched is like waitUntil, for the conditions which only read the objects of the watched kind: once the informer has
+// synced, such a condition can only change when an event is received, so it's re-evaluated every few seconds only in case an
+// event was missed.
+func (a *Awaitility) waitUntilWatched(t testing.TB, watched client.Object, namespace string, timeout time.Duration, condition wait.ConditionWithContextFunc) error {
+	return a.waitForEvents(t, watched, namespace, timeout, true, condition)
+}
+
+func (a *Awaitility) waitForEvents(t testing.TB, watched client.Object, namespace string, timeout time.Duration, onlyWatched bool, condition wait.ConditionWithContextFunc) (err error) {
+	m := a.measure(t, watched)
+	defer func() {
+		m.done(err)
+	}()
+	condition = m.counting(condition)
+	next := a.intervals(watched)
+	kw := a.watch(watched, namespace)
+	if kw == nil {
+		ctx, cancel := waitContext(t, timeout)
+		defer cancel()
+		return pollWithIntervals(ctx, next, condition)
+	}
+	events, unsubscribe := kw.subscribe()
+	defer unsubscribe()
+
+	ctx, cancel := waitContext(t, timeout)
+	defer cancel()
+	for {
+		evaluated := time.Now()
+		if done, err := condition(ctx); err != nil || done {
+			return err
+		}
+		// until the informer has synced (or if it never does), the events may be missing: keep polling meanwhile
+		interval := next()
+		wakeUp := interval
+		if onlyWatched && kw.synced() {
+			wakeUp = max(resyncInterval, interval)
+		}
+		timer := time.NewTimer(wakeUp)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-events:
+		case <-timer.C:
+		}
+		timer.Stop()
+		if remaining := interval - time.Since(evaluated); remaining > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(remaining):
+			}
+		}
+	}
+}
+
+// cachedReader returns the informer cache of the kind of the given object if it's watched and synced (and true), or the client
+// otherwise. The cache may lag behind the cluster, so it must only be used to find the objects which match a condition: the absence
+// of an object, or the fact that no object (or only some of them) matches, must be checked with the client.
+func (a *Awaitility) cachedReader(watched client.Object, namespace string) (client.Reader, bool) {
+	if kw := a.watch(watched, namespace); kw != nil && kw.synced() {
+		return kw.cache, true
+	}
+	return a.Client, false
+}
@@ -0,0 +1,96 @@
+package wait
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestWaitUntil(t *testing.T) {
+	t.Run("polls when there is no rest config", func(t *testing.T) {
+		// given
+		a := &Awaitility{RetryInterval: time.Millisecond}
+		count := 0
+
+		// when
+		err := a.waitUntil(t, &corev1.ConfigMap{}, "", time.Second, func(_ context.Context) (bool, error) {
+			count++
+			return count == 3, nil
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		// given
+		a := &Awaitility{RetryInterval: time.Millisecond}
+
+		// when
+		err := a.waitUntil(t, &corev1.ConfigMap{}, "", 10*time.Millisecond, func(_ context.Context) (bool, error) {
+			return false, nil
+		})
+
+		// then
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestKindWatch(t *testing.T) {
+	// given
+	kw := &kindWatch{subscribers: map[chan struct{}]struct{}{}, observers: map[*observer]struct{}{}}
+	first, unsubscribeFirst := kw.subscribe()
+	second, unsubscribeSecond := kw.subscribe()
+	defer unsubscribeSecond()
+
+	// when
+	kw.notify(addedEvent, nil)
+	kw.notify(modifiedEvent, nil) // doesn't block even if the subscribers haven't consumed the previous notification
+
+	// then
+	assert.Len(t, first, 1)
+	assert.Len(t, second, 1)
+	assert.False(t, kw.synced())
+
+	t.Run("no notification after unsubscribing", func(t *testing.T) {
+		// given
+		<-first
+		unsubscribeFirst()
+
+		// when
+		kw.notify(modifiedEvent, nil)
+
+		// then
+		assert.Empty(t, first)
+	})
+}
+
+func TestWatchOnlyOperatorNamespaces(t *testing.T) {
+	// given
+	cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	a := &Awaitility{RestConfig: &rest.Config{Host: "https://localhost:1"}, Client: cl, Namespace: "operator"}
+
+	t.Run("user namespace", func(t *testing.T) {
+		// when
+		kw := a.watch(&corev1.ConfigMap{}, "user-dev")
+
+		// then
+		assert.Nil(t, kw)
+	})
+
+	t.Run("namespaced kind in all namespaces", func(t *testing.T) {
+		// when
+		kw := a.watch(&corev1.ConfigMap{}, "")
+
+		// then
+		assert.Nil(t, kw)
+	})
+}
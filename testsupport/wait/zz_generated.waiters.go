@@ -0,0 +1,713 @@
+// Code generated by waitersgen. DO NOT EDIT.
+
+package wait
+
+import (
+	"fmt"
+	"testing"
+
+	toolchainv1alpha1 "github.com/codeready-toolchain/api/api/v1alpha1"
+	"github.com/codeready-toolchain/toolchain-common/pkg/test"
+)
+
+// BannedUserWaitCriterion a struct to compare with an expected BannedUser
+type BannedUserWaitCriterion = WaitCriterion[*toolchainv1alpha1.BannedUser]
+
+// ForBannedUser returns a waiter for the objects of kind BannedUser in the namespace of the awaitility
+func (a *Awaitility) ForBannedUser(t testing.TB) *TypedWaiter[*toolchainv1alpha1.BannedUser] {
+	return newTypedWaiter(t, a, "BannedUser", func() *toolchainv1alpha1.BannedUser {
+		return &toolchainv1alpha1.BannedUser{}
+	})
+}
+
+// UntilBannedUserIsBeingDeleted checks that the BannedUser has a deletion timestamp
+func UntilBannedUserIsBeingDeleted() BannedUserWaitCriterion {
+	return BannedUserWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.BannedUser) bool {
+			return actual.DeletionTimestamp != nil
+		},
+		Diff: func(_ *toolchainv1alpha1.BannedUser) string {
+			return "expected a deletion timestamp"
+		},
+	}
+}
+
+// UntilBannedUserHasLabel checks that the BannedUser has the given label
+func UntilBannedUserHasLabel(key, value string) BannedUserWaitCriterion {
+	return BannedUserWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.BannedUser) bool {
+			return actual.Labels != nil && actual.Labels[key] == value
+		},
+		Diff: func(actual *toolchainv1alpha1.BannedUser) string {
+			return fmt.Sprintf("expected label '%s' to have value '%s'\nactual labels: %v", key, value, actual.Labels)
+		},
+	}
+}
+
+// IdlerWaitCriterion a struct to compare with an expected Idler
+type IdlerWaitCriterion = WaitCriterion[*toolchainv1alpha1.Idler]
+
+// ForIdler returns a waiter for the objects of kind Idler in the namespace of the awaitility
+func (a *Awaitility) ForIdler(t testing.TB) *TypedWaiter[*toolchainv1alpha1.Idler] {
+	return newTypedWaiter(t, a, "Idler", func() *toolchainv1alpha1.Idler {
+		return &toolchainv1alpha1.Idler{}
+	})
+}
+
+// UntilIdlerIsBeingDeleted checks that the Idler has a deletion timestamp
+func UntilIdlerIsBeingDeleted() IdlerWaitCriterion {
+	return IdlerWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.Idler) bool {
+			return actual.DeletionTimestamp != nil
+		},
+		Diff: func(_ *toolchainv1alpha1.Idler) string {
+			return "expected a deletion timestamp"
+		},
+	}
+}
+
+// UntilIdlerHasLabel checks that the Idler has the given label
+func UntilIdlerHasLabel(key, value string) IdlerWaitCriterion {
+	return IdlerWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.Idler) bool {
+			return actual.Labels != nil && actual.Labels[key] == value
+		},
+		Diff: func(actual *toolchainv1alpha1.Idler) string {
+			return fmt.Sprintf("expected label '%s' to have value '%s'\nactual labels: %v", key, value, actual.Labels)
+		},
+	}
+}
+
+// UntilIdlerHasConditions checks that the Idler has exactly all the given status conditions
+func UntilIdlerHasConditions(expected ...toolchainv1alpha1.Condition) IdlerWaitCriterion {
+	return IdlerWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.Idler) bool {
+			return test.ConditionsMatch(actual.Status.Conditions, expected...)
+		},
+		Diff: func(actual *toolchainv1alpha1.Idler) string {
+			return fmt.Sprintf("expected conditions to match:\n%s", Diff(expected, actual.Status.Conditions))
+		},
+	}
+}
+
+// MasterUserRecordWaitCriterion a struct to compare with an expected MasterUserRecord
+type MasterUserRecordWaitCriterion = WaitCriterion[*toolchainv1alpha1.MasterUserRecord]
+
+// ForMasterUserRecord returns a waiter for the objects of kind MasterUserRecord in the namespace of the awaitility
+func (a *Awaitility) ForMasterUserRecord(t testing.TB) *TypedWaiter[*toolchainv1alpha1.MasterUserRecord] {
+	return newTypedWaiter(t, a, "MasterUserRecord", func() *toolchainv1alpha1.MasterUserRecord {
+		return &toolchainv1alpha1.MasterUserRecord{}
+	})
+}
+
+// UntilMasterUserRecordHasLabel checks that the MasterUserRecord has the given label
+func UntilMasterUserRecordHasLabel(key, value string) MasterUserRecordWaitCriterion {
+	return MasterUserRecordWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.MasterUserRecord) bool {
+			return actual.Labels != nil && actual.Labels[key] == value
+		},
+		Diff: func(actual *toolchainv1alpha1.MasterUserRecord) string {
+			return fmt.Sprintf("expected label '%s' to have value '%s'\nactual labels: %v", key, value, actual.Labels)
+		},
+	}
+}
+
+// MemberStatusWaitCriterion a struct to compare with an expected MemberStatus
+type MemberStatusWaitCriterion = WaitCriterion[*toolchainv1alpha1.MemberStatus]
+
+// ForMemberStatus returns a waiter for the objects of kind MemberStatus in the namespace of the awaitility
+func (a *Awaitility) ForMemberStatus(t testing.TB) *TypedWaiter[*toolchainv1alpha1.MemberStatus] {
+	return newTypedWaiter(t, a, "MemberStatus", func() *toolchainv1alpha1.MemberStatus {
+		return &toolchainv1alpha1.MemberStatus{}
+	})
+}
+
+// UntilMemberStatusIsBeingDeleted checks that the MemberStatus has a deletion timestamp
+func UntilMemberStatusIsBeingDeleted() MemberStatusWaitCriterion {
+	return MemberStatusWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.MemberStatus) bool {
+			return actual.DeletionTimestamp != nil
+		},
+		Diff: func(_ *toolchainv1alpha1.MemberStatus) string {
+			return "expected a deletion timestamp"
+		},
+	}
+}
+
+// UntilMemberStatusHasLabel checks that the MemberStatus has the given label
+func UntilMemberStatusHasLabel(key, value string) MemberStatusWaitCriterion {
+	return MemberStatusWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.MemberStatus) bool {
+			return actual.Labels != nil && actual.Labels[key] == value
+		},
+		Diff: func(actual *toolchainv1alpha1.MemberStatus) string {
+			return fmt.Sprintf("expected label '%s' to have value '%s'\nactual labels: %v", key, value, actual.Labels)
+		},
+	}
+}
+
+// NSTemplateSetWaitCriterion a struct to compare with an expected NSTemplateSet
+type NSTemplateSetWaitCriterion = WaitCriterion[*toolchainv1alpha1.NSTemplateSet]
+
+// ForNSTemplateSet returns a waiter for the objects of kind NSTemplateSet in the namespace of the awaitility
+func (a *Awaitility) ForNSTemplateSet(t testing.TB) *TypedWaiter[*toolchainv1alpha1.NSTemplateSet] {
+	return newTypedWaiter(t, a, "NSTemplateSet", func() *toolchainv1alpha1.NSTemplateSet {
+		return &toolchainv1alpha1.NSTemplateSet{}
+	})
+}
+
+// UntilNSTemplateSetHasLabel checks that the NSTemplateSet has the given label
+func UntilNSTemplateSetHasLabel(key, value string) NSTemplateSetWaitCriterion {
+	return NSTemplateSetWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.NSTemplateSet) bool {
+			return actual.Labels != nil && actual.Labels[key] == value
+		},
+		Diff: func(actual *toolchainv1alpha1.NSTemplateSet) string {
+			return fmt.Sprintf("expected label '%s' to have value '%s'\nactual labels: %v", key, value, actual.Labels)
+		},
+	}
+}
+
+// NSTemplateTierWaitCriterion a struct to compare with an expected NSTemplateTier
+type NSTemplateTierWaitCriterion = WaitCriterion[*toolchainv1alpha1.NSTemplateTier]
+
+// ForNSTemplateTier returns a waiter for the objects of kind NSTemplateTier in the namespace of the awaitility
+func (a *Awaitility) ForNSTemplateTier(t testing.TB) *TypedWaiter[*toolchainv1alpha1.NSTemplateTier] {
+	return newTypedWaiter(t, a, "NSTemplateTier", func() *toolchainv1alpha1.NSTemplateTier {
+		return &toolchainv1alpha1.NSTemplateTier{}
+	})
+}
+
+// UntilNSTemplateTierIsBeingDeleted checks that the NSTemplateTier has a deletion timestamp
+func UntilNSTemplateTierIsBeingDeleted() NSTemplateTierWaitCriterion {
+	return NSTemplateTierWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.NSTemplateTier) bool {
+			return actual.DeletionTimestamp != nil
+		},
+		Diff: func(_ *toolchainv1alpha1.NSTemplateTier) string {
+			return "expected a deletion timestamp"
+		},
+	}
+}
+
+// UntilNSTemplateTierHasLabel checks that the NSTemplateTier has the given label
+func UntilNSTemplateTierHasLabel(key, value string) NSTemplateTierWaitCriterion {
+	return NSTemplateTierWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.NSTemplateTier) bool {
+			return actual.Labels != nil && actual.Labels[key] == value
+		},
+		Diff: func(actual *toolchainv1alpha1.NSTemplateTier) string {
+			return fmt.Sprintf("expected label '%s' to have value '%s'\nactual labels: %v", key, value, actual.Labels)
+		},
+	}
+}
+
+// UntilNSTemplateTierHasConditions checks that the NSTemplateTier has exactly all the given status conditions
+func UntilNSTemplateTierHasConditions(expected ...toolchainv1alpha1.Condition) NSTemplateTierWaitCriterion {
+	return NSTemplateTierWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.NSTemplateTier) bool {
+			return test.ConditionsMatch(actual.Status.Conditions, expected...)
+		},
+		Diff: func(actual *toolchainv1alpha1.NSTemplateTier) string {
+			return fmt.Sprintf("expected conditions to match:\n%s", Diff(expected, actual.Status.Conditions))
+		},
+	}
+}
+
+// ProxyPluginWaitCriterion a struct to compare with an expected ProxyPlugin
+type ProxyPluginWaitCriterion = WaitCriterion[*toolchainv1alpha1.ProxyPlugin]
+
+// ForProxyPlugin returns a waiter for the objects of kind ProxyPlugin in the namespace of the awaitility
+func (a *Awaitility) ForProxyPlugin(t testing.TB) *TypedWaiter[*toolchainv1alpha1.ProxyPlugin] {
+	return newTypedWaiter(t, a, "ProxyPlugin", func() *toolchainv1alpha1.ProxyPlugin {
+		return &toolchainv1alpha1.ProxyPlugin{}
+	})
+}
+
+// UntilProxyPluginIsBeingDeleted checks that the ProxyPlugin has a deletion timestamp
+func UntilProxyPluginIsBeingDeleted() ProxyPluginWaitCriterion {
+	return ProxyPluginWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.ProxyPlugin) bool {
+			return actual.DeletionTimestamp != nil
+		},
+		Diff: func(_ *toolchainv1alpha1.ProxyPlugin) string {
+			return "expected a deletion timestamp"
+		},
+	}
+}
+
+// UntilProxyPluginHasLabel checks that the ProxyPlugin has the given label
+func UntilProxyPluginHasLabel(key, value string) ProxyPluginWaitCriterion {
+	return ProxyPluginWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.ProxyPlugin) bool {
+			return actual.Labels != nil && actual.Labels[key] == value
+		},
+		Diff: func(actual *toolchainv1alpha1.ProxyPlugin) string {
+			return fmt.Sprintf("expected label '%s' to have value '%s'\nactual labels: %v", key, value, actual.Labels)
+		},
+	}
+}
+
+// UntilProxyPluginHasConditions checks that the ProxyPlugin has exactly all the given status conditions
+func UntilProxyPluginHasConditions(expected ...toolchainv1alpha1.Condition) ProxyPluginWaitCriterion {
+	return ProxyPluginWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.ProxyPlugin) bool {
+			return test.ConditionsMatch(actual.Status.Conditions, expected...)
+		},
+		Diff: func(actual *toolchainv1alpha1.ProxyPlugin) string {
+			return fmt.Sprintf("expected conditions to match:\n%s", Diff(expected, actual.Status.Conditions))
+		},
+	}
+}
+
+// SocialEventWaitCriterion a struct to compare with an expected SocialEvent
+type SocialEventWaitCriterion = WaitCriterion[*toolchainv1alpha1.SocialEvent]
+
+// ForSocialEvent returns a waiter for the objects of kind SocialEvent in the namespace of the awaitility
+func (a *Awaitility) ForSocialEvent(t testing.TB) *TypedWaiter[*toolchainv1alpha1.SocialEvent] {
+	return newTypedWaiter(t, a, "SocialEvent", func() *toolchainv1alpha1.SocialEvent {
+		return &toolchainv1alpha1.SocialEvent{}
+	})
+}
+
+// UntilSocialEventIsBeingDeleted checks that the SocialEvent has a deletion timestamp
+func UntilSocialEventIsBeingDeleted() SocialEventWaitCriterion {
+	return SocialEventWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.SocialEvent) bool {
+			return actual.DeletionTimestamp != nil
+		},
+		Diff: func(_ *toolchainv1alpha1.SocialEvent) string {
+			return "expected a deletion timestamp"
+		},
+	}
+}
+
+// UntilSocialEventHasLabel checks that the SocialEvent has the given label
+func UntilSocialEventHasLabel(key, value string) SocialEventWaitCriterion {
+	return SocialEventWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.SocialEvent) bool {
+			return actual.Labels != nil && actual.Labels[key] == value
+		},
+		Diff: func(actual *toolchainv1alpha1.SocialEvent) string {
+			return fmt.Sprintf("expected label '%s' to have value '%s'\nactual labels: %v", key, value, actual.Labels)
+		},
+	}
+}
+
+// SpaceWaitCriterion a struct to compare with an expected Space
+type SpaceWaitCriterion = WaitCriterion[*toolchainv1alpha1.Space]
+
+// ForSpace returns a waiter for the objects of kind Space in the namespace of the awaitility
+func (a *Awaitility) ForSpace(t testing.TB) *TypedWaiter[*toolchainv1alpha1.Space] {
+	return newTypedWaiter(t, a, "Space", func() *toolchainv1alpha1.Space {
+		return &toolchainv1alpha1.Space{}
+	})
+}
+
+// UntilSpaceHasLabel checks that the Space has the given label
+func UntilSpaceHasLabel(key, value string) SpaceWaitCriterion {
+	return SpaceWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.Space) bool {
+			return actual.Labels != nil && actual.Labels[key] == value
+		},
+		Diff: func(actual *toolchainv1alpha1.Space) string {
+			return fmt.Sprintf("expected label '%s' to have value '%s'\nactual labels: %v", key, value, actual.Labels)
+		},
+	}
+}
+
+// SpaceBindingWaitCriterion a struct to compare with an expected SpaceBinding
+type SpaceBindingWaitCriterion = WaitCriterion[*toolchainv1alpha1.SpaceBinding]
+
+// ForSpaceBinding returns a waiter for the objects of kind SpaceBinding in the namespace of the awaitility
+func (a *Awaitility) ForSpaceBinding(t testing.TB) *TypedWaiter[*toolchainv1alpha1.SpaceBinding] {
+	return newTypedWaiter(t, a, "SpaceBinding", func() *toolchainv1alpha1.SpaceBinding {
+		return &toolchainv1alpha1.SpaceBinding{}
+	})
+}
+
+// UntilSpaceBindingIsBeingDeleted checks that the SpaceBinding has a deletion timestamp
+func UntilSpaceBindingIsBeingDeleted() SpaceBindingWaitCriterion {
+	return SpaceBindingWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.SpaceBinding) bool {
+			return actual.DeletionTimestamp != nil
+		},
+		Diff: func(_ *toolchainv1alpha1.SpaceBinding) string {
+			return "expected a deletion timestamp"
+		},
+	}
+}
+
+// SpaceBindingRequestWaitCriterion a struct to compare with an expected SpaceBindingRequest
+type SpaceBindingRequestWaitCriterion = WaitCriterion[*toolchainv1alpha1.SpaceBindingRequest]
+
+// ForSpaceBindingRequest returns a waiter for the objects of kind SpaceBindingRequest in the namespace of the awaitility
+func (a *Awaitility) ForSpaceBindingRequest(t testing.TB) *TypedWaiter[*toolchainv1alpha1.SpaceBindingRequest] {
+	return newTypedWaiter(t, a, "SpaceBindingRequest", func() *toolchainv1alpha1.SpaceBindingRequest {
+		return &toolchainv1alpha1.SpaceBindingRequest{}
+	})
+}
+
+// UntilSpaceBindingRequestIsBeingDeleted checks that the SpaceBindingRequest has a deletion timestamp
+func UntilSpaceBindingRequestIsBeingDeleted() SpaceBindingRequestWaitCriterion {
+	return SpaceBindingRequestWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.SpaceBindingRequest) bool {
+			return actual.DeletionTimestamp != nil
+		},
+		Diff: func(_ *toolchainv1alpha1.SpaceBindingRequest) string {
+			return "expected a deletion timestamp"
+		},
+	}
+}
+
+// UntilSpaceBindingRequestHasLabel checks that the SpaceBindingRequest has the given label
+func UntilSpaceBindingRequestHasLabel(key, value string) SpaceBindingRequestWaitCriterion {
+	return SpaceBindingRequestWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.SpaceBindingRequest) bool {
+			return actual.Labels != nil && actual.Labels[key] == value
+		},
+		Diff: func(actual *toolchainv1alpha1.SpaceBindingRequest) string {
+			return fmt.Sprintf("expected label '%s' to have value '%s'\nactual labels: %v", key, value, actual.Labels)
+		},
+	}
+}
+
+// SpaceProvisionerConfigWaitCriterion a struct to compare with an expected SpaceProvisionerConfig
+type SpaceProvisionerConfigWaitCriterion = WaitCriterion[*toolchainv1alpha1.SpaceProvisionerConfig]
+
+// ForSpaceProvisionerConfig returns a waiter for the objects of kind SpaceProvisionerConfig in the namespace of the awaitility
+func (a *Awaitility) ForSpaceProvisionerConfig(t testing.TB) *TypedWaiter[*toolchainv1alpha1.SpaceProvisionerConfig] {
+	return newTypedWaiter(t, a, "SpaceProvisionerConfig", func() *toolchainv1alpha1.SpaceProvisionerConfig {
+		return &toolchainv1alpha1.SpaceProvisionerConfig{}
+	})
+}
+
+// UntilSpaceProvisionerConfigIsBeingDeleted checks that the SpaceProvisionerConfig has a deletion timestamp
+func UntilSpaceProvisionerConfigIsBeingDeleted() SpaceProvisionerConfigWaitCriterion {
+	return SpaceProvisionerConfigWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.SpaceProvisionerConfig) bool {
+			return actual.DeletionTimestamp != nil
+		},
+		Diff: func(_ *toolchainv1alpha1.SpaceProvisionerConfig) string {
+			return "expected a deletion timestamp"
+		},
+	}
+}
+
+// UntilSpaceProvisionerConfigHasLabel checks that the SpaceProvisionerConfig has the given label
+func UntilSpaceProvisionerConfigHasLabel(key, value string) SpaceProvisionerConfigWaitCriterion {
+	return SpaceProvisionerConfigWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.SpaceProvisionerConfig) bool {
+			return actual.Labels != nil && actual.Labels[key] == value
+		},
+		Diff: func(actual *toolchainv1alpha1.SpaceProvisionerConfig) string {
+			return fmt.Sprintf("expected label '%s' to have value '%s'\nactual labels: %v", key, value, actual.Labels)
+		},
+	}
+}
+
+// UntilSpaceProvisionerConfigHasConditions checks that the SpaceProvisionerConfig has exactly all the given status conditions
+func UntilSpaceProvisionerConfigHasConditions(expected ...toolchainv1alpha1.Condition) SpaceProvisionerConfigWaitCriterion {
+	return SpaceProvisionerConfigWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.SpaceProvisionerConfig) bool {
+			return test.ConditionsMatch(actual.Status.Conditions, expected...)
+		},
+		Diff: func(actual *toolchainv1alpha1.SpaceProvisionerConfig) string {
+			return fmt.Sprintf("expected conditions to match:\n%s", Diff(expected, actual.Status.Conditions))
+		},
+	}
+}
+
+// SpaceRequestWaitCriterion a struct to compare with an expected SpaceRequest
+type SpaceRequestWaitCriterion = WaitCriterion[*toolchainv1alpha1.SpaceRequest]
+
+// ForSpaceRequest returns a waiter for the objects of kind SpaceRequest in the namespace of the awaitility
+func (a *Awaitility) ForSpaceRequest(t testing.TB) *TypedWaiter[*toolchainv1alpha1.SpaceRequest] {
+	return newTypedWaiter(t, a, "SpaceRequest", func() *toolchainv1alpha1.SpaceRequest {
+		return &toolchainv1alpha1.SpaceRequest{}
+	})
+}
+
+// UntilSpaceRequestIsBeingDeleted checks that the SpaceRequest has a deletion timestamp
+func UntilSpaceRequestIsBeingDeleted() SpaceRequestWaitCriterion {
+	return SpaceRequestWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.SpaceRequest) bool {
+			return actual.DeletionTimestamp != nil
+		},
+		Diff: func(_ *toolchainv1alpha1.SpaceRequest) string {
+			return "expected a deletion timestamp"
+		},
+	}
+}
+
+// UntilSpaceRequestHasLabel checks that the SpaceRequest has the given label
+func UntilSpaceRequestHasLabel(key, value string) SpaceRequestWaitCriterion {
+	return SpaceRequestWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.SpaceRequest) bool {
+			return actual.Labels != nil && actual.Labels[key] == value
+		},
+		Diff: func(actual *toolchainv1alpha1.SpaceRequest) string {
+			return fmt.Sprintf("expected label '%s' to have value '%s'\nactual labels: %v", key, value, actual.Labels)
+		},
+	}
+}
+
+// TierTemplateWaitCriterion a struct to compare with an expected TierTemplate
+type TierTemplateWaitCriterion = WaitCriterion[*toolchainv1alpha1.TierTemplate]
+
+// ForTierTemplate returns a waiter for the objects of kind TierTemplate in the namespace of the awaitility
+func (a *Awaitility) ForTierTemplate(t testing.TB) *TypedWaiter[*toolchainv1alpha1.TierTemplate] {
+	return newTypedWaiter(t, a, "TierTemplate", func() *toolchainv1alpha1.TierTemplate {
+		return &toolchainv1alpha1.TierTemplate{}
+	})
+}
+
+// UntilTierTemplateIsBeingDeleted checks that the TierTemplate has a deletion timestamp
+func UntilTierTemplateIsBeingDeleted() TierTemplateWaitCriterion {
+	return TierTemplateWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.TierTemplate) bool {
+			return actual.DeletionTimestamp != nil
+		},
+		Diff: func(_ *toolchainv1alpha1.TierTemplate) string {
+			return "expected a deletion timestamp"
+		},
+	}
+}
+
+// UntilTierTemplateHasLabel checks that the TierTemplate has the given label
+func UntilTierTemplateHasLabel(key, value string) TierTemplateWaitCriterion {
+	return TierTemplateWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.TierTemplate) bool {
+			return actual.Labels != nil && actual.Labels[key] == value
+		},
+		Diff: func(actual *toolchainv1alpha1.TierTemplate) string {
+			return fmt.Sprintf("expected label '%s' to have value '%s'\nactual labels: %v", key, value, actual.Labels)
+		},
+	}
+}
+
+// ToolchainClusterWaitCriterion a struct to compare with an expected ToolchainCluster
+type ToolchainClusterWaitCriterion = WaitCriterion[*toolchainv1alpha1.ToolchainCluster]
+
+// ForToolchainCluster returns a waiter for the objects of kind ToolchainCluster in the namespace of the awaitility
+func (a *Awaitility) ForToolchainCluster(t testing.TB) *TypedWaiter[*toolchainv1alpha1.ToolchainCluster] {
+	return newTypedWaiter(t, a, "ToolchainCluster", func() *toolchainv1alpha1.ToolchainCluster {
+		return &toolchainv1alpha1.ToolchainCluster{}
+	})
+}
+
+// UntilToolchainClusterIsBeingDeleted checks that the ToolchainCluster has a deletion timestamp
+func UntilToolchainClusterIsBeingDeleted() ToolchainClusterWaitCriterion {
+	return ToolchainClusterWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.ToolchainCluster) bool {
+			return actual.DeletionTimestamp != nil
+		},
+		Diff: func(_ *toolchainv1alpha1.ToolchainCluster) string {
+			return "expected a deletion timestamp"
+		},
+	}
+}
+
+// UntilToolchainClusterHasLabel checks that the ToolchainCluster has the given label
+func UntilToolchainClusterHasLabel(key, value string) ToolchainClusterWaitCriterion {
+	return ToolchainClusterWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.ToolchainCluster) bool {
+			return actual.Labels != nil && actual.Labels[key] == value
+		},
+		Diff: func(actual *toolchainv1alpha1.ToolchainCluster) string {
+			return fmt.Sprintf("expected label '%s' to have value '%s'\nactual labels: %v", key, value, actual.Labels)
+		},
+	}
+}
+
+// UntilToolchainClusterHasConditions checks that the ToolchainCluster has exactly all the given status conditions
+func UntilToolchainClusterHasConditions(expected ...toolchainv1alpha1.Condition) ToolchainClusterWaitCriterion {
+	return ToolchainClusterWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.ToolchainCluster) bool {
+			return test.ConditionsMatch(actual.Status.Conditions, expected...)
+		},
+		Diff: func(actual *toolchainv1alpha1.ToolchainCluster) string {
+			return fmt.Sprintf("expected conditions to match:\n%s", Diff(expected, actual.Status.Conditions))
+		},
+	}
+}
+
+// ToolchainConfigWaitCriterion a struct to compare with an expected ToolchainConfig
+type ToolchainConfigWaitCriterion = WaitCriterion[*toolchainv1alpha1.ToolchainConfig]
+
+// ForToolchainConfig returns a waiter for the objects of kind ToolchainConfig in the namespace of the awaitility
+func (a *Awaitility) ForToolchainConfig(t testing.TB) *TypedWaiter[*toolchainv1alpha1.ToolchainConfig] {
+	return newTypedWaiter(t, a, "ToolchainConfig", func() *toolchainv1alpha1.ToolchainConfig {
+		return &toolchainv1alpha1.ToolchainConfig{}
+	})
+}
+
+// UntilToolchainConfigIsBeingDeleted checks that the ToolchainConfig has a deletion timestamp
+func UntilToolchainConfigIsBeingDeleted() ToolchainConfigWaitCriterion {
+	return ToolchainConfigWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.ToolchainConfig) bool {
+			return actual.DeletionTimestamp != nil
+		},
+		Diff: func(_ *toolchainv1alpha1.ToolchainConfig) string {
+			return "expected a deletion timestamp"
+		},
+	}
+}
+
+// UntilToolchainConfigHasLabel checks that the ToolchainConfig has the given label
+func UntilToolchainConfigHasLabel(key, value string) ToolchainConfigWaitCriterion {
+	return ToolchainConfigWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.ToolchainConfig) bool {
+			return actual.Labels != nil && actual.Labels[key] == value
+		},
+		Diff: func(actual *toolchainv1alpha1.ToolchainConfig) string {
+			return fmt.Sprintf("expected label '%s' to have value '%s'\nactual labels: %v", key, value, actual.Labels)
+		},
+	}
+}
+
+// UntilToolchainConfigHasConditions checks that the ToolchainConfig has exactly all the given status conditions
+func UntilToolchainConfigHasConditions(expected ...toolchainv1alpha1.Condition) ToolchainConfigWaitCriterion {
+	return ToolchainConfigWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.ToolchainConfig) bool {
+			return test.ConditionsMatch(actual.Status.Conditions, expected...)
+		},
+		Diff: func(actual *toolchainv1alpha1.ToolchainConfig) string {
+			return fmt.Sprintf("expected conditions to match:\n%s", Diff(expected, actual.Status.Conditions))
+		},
+	}
+}
+
+// ToolchainStatusWaitCriterion a struct to compare with an expected ToolchainStatus
+type ToolchainStatusWaitCriterion = WaitCriterion[*toolchainv1alpha1.ToolchainStatus]
+
+// ForToolchainStatus returns a waiter for the objects of kind ToolchainStatus in the namespace of the awaitility
+func (a *Awaitility) ForToolchainStatus(t testing.TB) *TypedWaiter[*toolchainv1alpha1.ToolchainStatus] {
+	return newTypedWaiter(t, a, "ToolchainStatus", func() *toolchainv1alpha1.ToolchainStatus {
+		return &toolchainv1alpha1.ToolchainStatus{}
+	})
+}
+
+// UntilToolchainStatusIsBeingDeleted checks that the ToolchainStatus has a deletion timestamp
+func UntilToolchainStatusIsBeingDeleted() ToolchainStatusWaitCriterion {
+	return ToolchainStatusWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.ToolchainStatus) bool {
+			return actual.DeletionTimestamp != nil
+		},
+		Diff: func(_ *toolchainv1alpha1.ToolchainStatus) string {
+			return "expected a deletion timestamp"
+		},
+	}
+}
+
+// UntilToolchainStatusHasLabel checks that the ToolchainStatus has the given label
+func UntilToolchainStatusHasLabel(key, value string) ToolchainStatusWaitCriterion {
+	return ToolchainStatusWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.ToolchainStatus) bool {
+			return actual.Labels != nil && actual.Labels[key] == value
+		},
+		Diff: func(actual *toolchainv1alpha1.ToolchainStatus) string {
+			return fmt.Sprintf("expected label '%s' to have value '%s'\nactual labels: %v", key, value, actual.Labels)
+		},
+	}
+}
+
+// UserAccountWaitCriterion a struct to compare with an expected UserAccount
+type UserAccountWaitCriterion = WaitCriterion[*toolchainv1alpha1.UserAccount]
+
+// ForUserAccount returns a waiter for the objects of kind UserAccount in the namespace of the awaitility
+func (a *Awaitility) ForUserAccount(t testing.TB) *TypedWaiter[*toolchainv1alpha1.UserAccount] {
+	return newTypedWaiter(t, a, "UserAccount", func() *toolchainv1alpha1.UserAccount {
+		return &toolchainv1alpha1.UserAccount{}
+	})
+}
+
+// UntilUserAccountHasLabel checks that the UserAccount has the given label
+func UntilUserAccountHasLabel(key, value string) UserAccountWaitCriterion {
+	return UserAccountWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.UserAccount) bool {
+			return actual.Labels != nil && actual.Labels[key] == value
+		},
+		Diff: func(actual *toolchainv1alpha1.UserAccount) string {
+			return fmt.Sprintf("expected label '%s' to have value '%s'\nactual labels: %v", key, value, actual.Labels)
+		},
+	}
+}
+
+// UserSignupWaitCriterion a struct to compare with an expected UserSignup
+type UserSignupWaitCriterion = WaitCriterion[*toolchainv1alpha1.UserSignup]
+
+// ForUserSignup returns a waiter for the objects of kind UserSignup in the namespace of the awaitility
+func (a *Awaitility) ForUserSignup(t testing.TB) *TypedWaiter[*toolchainv1alpha1.UserSignup] {
+	return newTypedWaiter(t, a, "UserSignup", func() *toolchainv1alpha1.UserSignup {
+		return &toolchainv1alpha1.UserSignup{}
+	})
+}
+
+// UserTierWaitCriterion a struct to compare with an expected UserTier
+type UserTierWaitCriterion = WaitCriterion[*toolchainv1alpha1.UserTier]
+
+// ForUserTier returns a waiter for the objects of kind UserTier in the namespace of the awaitility
+func (a *Awaitility) ForUserTier(t testing.TB) *TypedWaiter[*toolchainv1alpha1.UserTier] {
+	return newTypedWaiter(t, a, "UserTier", func() *toolchainv1alpha1.UserTier {
+		return &toolchainv1alpha1.UserTier{}
+	})
+}
+
+// UntilUserTierIsBeingDeleted checks that the UserTier has a deletion timestamp
+func UntilUserTierIsBeingDeleted() UserTierWaitCriterion {
+	return UserTierWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.UserTier) bool {
+			return actual.DeletionTimestamp != nil
+		},
+		Diff: func(_ *toolchainv1alpha1.UserTier) string {
+			return "expected a deletion timestamp"
+		},
+	}
+}
+
+// UntilUserTierHasLabel checks that the UserTier has the given label
+func UntilUserTierHasLabel(key, value string) UserTierWaitCriterion {
+	return UserTierWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.UserTier) bool {
+			return actual.Labels != nil && actual.Labels[key] == value
+		},
+		Diff: func(actual *toolchainv1alpha1.UserTier) string {
+			return fmt.Sprintf("expected label '%s' to have value '%s'\nactual labels: %v", key, value, actual.Labels)
+		},
+	}
+}
+
+// WorkspaceWaitCriterion a struct to compare with an expected Workspace
+type WorkspaceWaitCriterion = WaitCriterion[*toolchainv1alpha1.Workspace]
+
+// ForWorkspace returns a waiter for the objects of kind Workspace in the namespace of the awaitility
+func (a *Awaitility) ForWorkspace(t testing.TB) *TypedWaiter[*toolchainv1alpha1.Workspace] {
+	return newTypedWaiter(t, a, "Workspace", func() *toolchainv1alpha1.Workspace {
+		return &toolchainv1alpha1.Workspace{}
+	})
+}
+
+// UntilWorkspaceIsBeingDeleted checks that the Workspace has a deletion timestamp
+func UntilWorkspaceIsBeingDeleted() WorkspaceWaitCriterion {
+	return WorkspaceWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.Workspace) bool {
+			return actual.DeletionTimestamp != nil
+		},
+		Diff: func(_ *toolchainv1alpha1.Workspace) string {
+			return "expected a deletion timestamp"
+		},
+	}
+}
+
+// UntilWorkspaceHasLabel checks that the Workspace has the given label
+func UntilWorkspaceHasLabel(key, value string) WorkspaceWaitCriterion {
+	return WorkspaceWaitCriterion{
+		Match: func(actual *toolchainv1alpha1.Workspace) bool {
+			return actual.Labels != nil && actual.Labels[key] == value
+		},
+		Diff: func(actual *toolchainv1alpha1.Workspace) string {
+			return fmt.Sprintf("expected label '%s' to have value '%s'\nactual labels: %v", key, value, actual.Labels)
+		},
+	}
+}